@@ -0,0 +1,52 @@
+package pingdom
+
+// ActionService provides an interface to the alerts sent by Pingdom.
+type ActionService struct {
+	client *Client
+}
+
+// List returns a page of the alerts matching the given query, most recent
+// first.  Use Limit and Offset to page through the alerts, or ListAll.
+func (as *ActionService) List(query ActionListQuery) ([]ActionAlert, error) {
+	if err := query.Valid(); err != nil {
+		return nil, err
+	}
+
+	req, err := as.client.NewRequest("GET", "/actions", query.GetParams())
+	if err != nil {
+		return nil, err
+	}
+
+	m := &actionsJSONResponse{}
+	_, err = as.client.Do(req, m)
+	if err != nil {
+		return nil, err
+	}
+	return m.Actions.Alerts, nil
+}
+
+// ListAll returns all the alerts matching the given query, following the
+// pages from the query's Offset until a page comes back short, or Pingdom
+// rejects an offset beyond the maximum it allows.  The query's Limit is used
+// as the page size, and defaults to the largest page allowed by the endpoint.
+func (as *ActionService) ListAll(query ActionListQuery) ([]ActionAlert, error) {
+	if query.Limit == 0 {
+		query.Limit = maxActionsLimit
+	}
+
+	var alerts []ActionAlert
+	for first := true; ; first = false {
+		page, err := as.List(query)
+		if err != nil {
+			if !first && isOffsetOutOfRange(err) {
+				return alerts, nil
+			}
+			return nil, err
+		}
+		alerts = append(alerts, page...)
+		if len(page) < query.Limit {
+			return alerts, nil
+		}
+		query.Offset += len(page)
+	}
+}
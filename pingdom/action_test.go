@@ -0,0 +1,116 @@
+package pingdom
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestActionServiceList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/actions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		assert.Equal(t, url.Values{"checkids": {"85975"}, "limit": {"1"}}, r.URL.Query())
+		fmt.Fprint(w, `{
+			"actions": {
+				"alerts": [
+					{
+						"contactname": "John Doe",
+						"contactid": 111250,
+						"checkid": 85975,
+						"time": 1294145577,
+						"via": "email",
+						"status": "sent",
+						"messageshort": "DOWN",
+						"messagefull": "Check example.com is DOWN",
+						"sentto": "johndoe@example.com",
+						"charged": false
+					}
+				]
+			}
+		}`)
+	})
+	want := []ActionAlert{
+		{
+			ContactName:  "John Doe",
+			ContactID:    111250,
+			CheckID:      85975,
+			Time:         1294145577,
+			Via:          "email",
+			Status:       "sent",
+			MessageShort: "DOWN",
+			MessageFull:  "Check example.com is DOWN",
+			SentTo:       "johndoe@example.com",
+		},
+	}
+
+	alerts, err := client.Actions.List(ActionListQuery{CheckIDs: []int{85975}, Limit: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, want, alerts)
+}
+
+func TestActionServiceListAll(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var offsets []string
+	mux.HandleFunc("/actions", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "2", r.URL.Query().Get("limit"))
+		offset := r.URL.Query().Get("offset")
+		offsets = append(offsets, offset)
+		switch offset {
+		case "":
+			fmt.Fprint(w, `{"actions": {"alerts": [{"checkid": 1, "time": 3}, {"checkid": 1, "time": 2}]}}`)
+		case "2":
+			fmt.Fprint(w, `{"actions": {"alerts": [{"checkid": 1, "time": 1}]}}`)
+		default:
+			t.Errorf("unexpected offset %q", offset)
+		}
+	})
+
+	alerts, err := client.Actions.ListAll(ActionListQuery{Limit: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, []ActionAlert{{CheckID: 1, Time: 3}, {CheckID: 1, Time: 2}, {CheckID: 1, Time: 1}}, alerts)
+	assert.Equal(t, []string{"", "2"}, offsets)
+}
+
+func TestActionServiceListAllOffsetBeyondMax(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/actions", func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("offset") {
+		case "":
+			fmt.Fprint(w, `{"actions": {"alerts": [{"checkid": 1, "time": 3}, {"checkid": 1, "time": 2}]}}`)
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"error":{"statuscode":400,"statusdesc":"Bad Request","errormessage":"Invalid parameter value: offset"}}`)
+		}
+	})
+
+	alerts, err := client.Actions.ListAll(ActionListQuery{Limit: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, []ActionAlert{{CheckID: 1, Time: 3}, {CheckID: 1, Time: 2}}, alerts)
+
+	_, err = client.Actions.ListAll(ActionListQuery{Limit: 2, Offset: 50000})
+	assert.Error(t, err, "an offset rejected on the first page is an error")
+}
+
+func TestActionServiceListAllDefaultLimit(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/actions", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "300", r.URL.Query().Get("limit"))
+		fmt.Fprint(w, `{"actions": {"alerts": []}}`)
+	})
+
+	alerts, err := client.Actions.ListAll(ActionListQuery{})
+	assert.NoError(t, err)
+	assert.Empty(t, alerts)
+}
@@ -0,0 +1,79 @@
+package pingdom
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// maxActionsLimit is the largest number of alerts the actions endpoint
+// returns in a single page.
+const maxActionsLimit = 300
+
+// ActionListQuery holds the filters supported when listing actions.
+type ActionListQuery struct {
+	From       int
+	To         int
+	Limit      int
+	Offset     int
+	CheckIDs   []int
+	ContactIDs []int
+	Status     []string
+	Via        []string
+}
+
+// Valid determines whether an ActionListQuery contains valid fields for the Pingdom API.
+func (q ActionListQuery) Valid() error {
+	if q.Limit < 0 || q.Limit > maxActionsLimit {
+		return fmt.Errorf("invalid value %d for `Limit`, must be between 0 and %d", q.Limit, maxActionsLimit)
+	}
+
+	if q.Offset < 0 {
+		return fmt.Errorf("invalid value for `Offset`, must not be negative")
+	}
+
+	if q.From != 0 && q.To != 0 && q.From > q.To {
+		return fmt.Errorf("invalid value for `From`, must not be after `To`")
+	}
+
+	return nil
+}
+
+// GetParams returns a map of params for a Pingdom ActionListQuery.
+func (q ActionListQuery) GetParams() map[string]string {
+	params := make(map[string]string)
+
+	if q.From != 0 {
+		params["from"] = strconv.Itoa(q.From)
+	}
+
+	if q.To != 0 {
+		params["to"] = strconv.Itoa(q.To)
+	}
+
+	if q.Limit != 0 {
+		params["limit"] = strconv.Itoa(q.Limit)
+	}
+
+	if q.Offset != 0 {
+		params["offset"] = strconv.Itoa(q.Offset)
+	}
+
+	if len(q.CheckIDs) > 0 {
+		params["checkids"] = intListToCDString(q.CheckIDs)
+	}
+
+	if len(q.ContactIDs) > 0 {
+		params["contactids"] = intListToCDString(q.ContactIDs)
+	}
+
+	if len(q.Status) > 0 {
+		params["status"] = strings.Join(q.Status, ",")
+	}
+
+	if len(q.Via) > 0 {
+		params["via"] = strings.Join(q.Via, ",")
+	}
+
+	return params
+}
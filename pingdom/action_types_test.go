@@ -0,0 +1,39 @@
+package pingdom
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestActionListQueryValid(t *testing.T) {
+	assert.NoError(t, ActionListQuery{}.Valid())
+	assert.NoError(t, ActionListQuery{Limit: 300, Offset: 600, From: 1, To: 2}.Valid())
+	assert.Error(t, ActionListQuery{Limit: 301}.Valid())
+	assert.Error(t, ActionListQuery{Limit: -1}.Valid())
+	assert.Error(t, ActionListQuery{Offset: -1}.Valid())
+	assert.Error(t, ActionListQuery{From: 2, To: 1}.Valid())
+}
+
+func TestActionListQueryGetParams(t *testing.T) {
+	assert.Equal(t, map[string]string{}, ActionListQuery{}.GetParams())
+	assert.Equal(t, map[string]string{
+		"from":       "1600000000",
+		"to":         "1600086400",
+		"limit":      "50",
+		"offset":     "100",
+		"checkids":   "1,2",
+		"contactids": "3",
+		"status":     "sent,delivered",
+		"via":        "email",
+	}, ActionListQuery{
+		From:       1600000000,
+		To:         1600086400,
+		Limit:      50,
+		Offset:     100,
+		CheckIDs:   []int{1, 2},
+		ContactIDs: []int{3},
+		Status:     []string{"sent", "delivered"},
+		Via:        []string{"email"},
+	}.GetParams())
+}
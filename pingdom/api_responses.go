@@ -3,6 +3,7 @@ package pingdom
 import (
 	"encoding/json"
 	"fmt"
+	"time"
 )
 
 // PingdomResponse represents a general response from the Pingdom API.
@@ -27,24 +28,90 @@ type CheckResponse struct {
 	NotifyWhenBackup         bool                `json:"notifywhenbackup,omitempty"`
 	Created                  int64               `json:"created,omitempty"`
 	Hostname                 string              `json:"hostname,omitempty"`
-	Status                   string              `json:"status,omitempty"`
+	Status                   CheckStatus         `json:"status,omitempty"`
 	LastErrorTime            int64               `json:"lasterrortime,omitempty"`
 	LastTestTime             int64               `json:"lasttesttime,omitempty"`
 	LastResponseTime         int64               `json:"lastresponsetime,omitempty"`
+	LastModified             int64               `json:"lastmodified,omitempty"`
 	Paused                   bool                `json:"paused,omitempty"`
 	IntegrationIds           []int               `json:"integrationids,omitempty"`
 	SeverityLevel            string              `json:"severity_level,omitempty"`
+	CustomMessage            string              `json:"custom_message,omitempty"`
 	Type                     CheckResponseType   `json:"type,omitempty"`
-	Tags                     []CheckResponseTag  `json:"tags,omitempty"`
+	Tags                     TagSet              `json:"tags,omitempty"`
 	UserIds                  []int               `json:"userids,omitempty"`
 	Teams                    []CheckTeamResponse `json:"teams,omitempty"`
 	ResponseTimeThreshold    int                 `json:"responsetime_threshold,omitempty"`
 	ProbeFilters             []string            `json:"probe_filters,omitempty"`
 	IPv6                     bool                `json:"ipv6,omitempty"`
 
+	// ContactIds holds the contacts alerted by checks still using the
+	// legacy notification model, see NotificationModel.
+	ContactIds []int `json:"contactids,omitempty"`
+
 	// Legacy; this is not returned by the API, we backfill the value from the
 	// Teams field.
 	TeamIds []int
+
+	// Location is the URL of the resource, set on create when the API
+	// returns a Location header.
+	Location string `json:"-"`
+}
+
+// HasRecipients reports whether the check alerts at least one user, team,
+// legacy contact or integration.  The Pingdom API does not expose account wide default
+// contacts, so a check without recipients notifies no one.  Note that users
+// and teams are only returned when reading a single check.
+func (c *CheckResponse) HasRecipients() bool {
+	return len(c.UserIds) != 0 || len(c.Teams) != 0 || len(c.TeamIds) != 0 ||
+		len(c.ContactIds) != 0 || len(c.IntegrationIds) != 0
+}
+
+// Age returns how long the check has existed at now, or 0 when its creation
+// time is unknown.  CheckService.Age uses the client's clock instead.
+func (c *CheckResponse) Age(now time.Time) time.Duration {
+	if c.Created == 0 {
+		return 0
+	}
+	return now.Sub(time.Unix(c.Created, 0))
+}
+
+// ModifiedAt returns the time the check was last modified, or the zero time
+// when Pingdom did not return it.
+func (c *CheckResponse) ModifiedAt() time.Time {
+	if c.LastModified == 0 {
+		return time.Time{}
+	}
+	return time.Unix(c.LastModified, 0).UTC()
+}
+
+// NotificationModel tells how a check designates whom it alerts.
+type NotificationModel string
+
+const (
+	// NotificationModelNone is used by checks alerting no contact, user or
+	// team.
+	NotificationModelNone NotificationModel = "none"
+	// NotificationModelLegacy is used by checks alerting legacy contacts,
+	// set with `contactids`.
+	NotificationModelLegacy NotificationModel = "legacy"
+	// NotificationModelModern is used by checks alerting users and teams,
+	// set with `userids` and `teamids`.
+	NotificationModelModern NotificationModel = "modern"
+)
+
+// NotificationModel returns the notification model used by the check.  A
+// check with any legacy contact is still on the legacy model, even if it also
+// alerts users or teams.  Like HasRecipients, this is only reliable for a
+// check read on its own.
+func (c *CheckResponse) NotificationModel() NotificationModel {
+	switch {
+	case len(c.ContactIds) != 0:
+		return NotificationModelLegacy
+	case len(c.UserIds) != 0 || len(c.Teams) != 0 || len(c.TeamIds) != 0:
+		return NotificationModelModern
+	}
+	return NotificationModelNone
 }
 
 // CheckTeamResponse is a Team returned inside of a Check instance. (We can't
@@ -56,12 +123,46 @@ type CheckTeamResponse struct {
 
 // CheckResponseType is the type of the Pingdom check.
 type CheckResponseType struct {
-	Name string                    `json:"-"`
+	Name CheckType                 `json:"-"`
 	HTTP *CheckResponseHTTPDetails `json:"http,omitempty"`
 	TCP  *CheckResponseTCPDetails  `json:"tcp,omitempty"`
 	DNS  *CheckResponseDNSDetails  `json:"dns,omitempty"`
 }
 
+// CheckStatus is the status of a Pingdom check.
+type CheckStatus string
+
+// The statuses a check can have.
+const (
+	StatusUp              CheckStatus = "up"
+	StatusDown            CheckStatus = "down"
+	StatusUnconfirmedDown CheckStatus = "unconfirmed_down"
+	StatusUnknown         CheckStatus = "unknown"
+	StatusPaused          CheckStatus = "paused"
+)
+
+// Known reports whether the status is one of the statuses this library knows
+// about.  Statuses added by Pingdom later are decoded as they are.
+func (s CheckStatus) Known() bool {
+	switch s {
+	case StatusUp, StatusDown, StatusUnconfirmedDown, StatusUnknown, StatusPaused:
+		return true
+	}
+	return false
+}
+
+// UnmarshalJSON decodes a status, leaving it empty rather than failing the
+// whole response when Pingdom sends something other than a string.
+func (s *CheckStatus) UnmarshalJSON(b []byte) error {
+	var status string
+	if err := json.Unmarshal(b, &status); err != nil {
+		*s = ""
+		return nil
+	}
+	*s = CheckStatus(status)
+	return nil
+}
+
 // CheckResponseTag is an optional tag that can be added to checks.
 type CheckResponseTag struct {
 	Name  string      `json:"name"`
@@ -79,12 +180,21 @@ type MaintenanceResponse struct {
 	RepeatEvery    int                      `json:"repeatevery"`
 	EffectiveTo    int64                    `json:"effectiveto"`
 	Checks         MaintenanceCheckResponse `json:"checks"`
+
+	// Location is set as for CheckResponse.Location.
+	Location string `json:"-"`
 }
 
 // MaintenanceCheckResponse represents Check reply in json MaintenanceResponse.
 type MaintenanceCheckResponse struct {
 	Uptime []int `json:"uptime"`
 	Tms    []int `json:"tms"`
+
+	// UptimeNames and TmsNames map the IDs of the covered checks to their
+	// names.  They are only filled in when reading a maintenance window with
+	// Resolve set, and lack the checks that no longer exist.
+	UptimeNames map[int]string `json:"-"`
+	TmsNames    map[int]string `json:"-"`
 }
 
 // ProbeResponse represents the JSON response for probes from the Pingdom API.
@@ -101,11 +211,20 @@ type ProbeResponse struct {
 	Region     string `json:"region"`
 }
 
+// SupportsIPv6 reports whether the probe can run IPv6 checks, which is the
+// case when it has an IPv6 address.
+func (p ProbeResponse) SupportsIPv6() bool {
+	return p.IPv6 != ""
+}
+
 // TeamResponse represents the JSON response for alerting teams from the Pingdom API.
 type TeamResponse struct {
 	ID      int                  `json:"id"`
 	Name    string               `json:"name,omitempty"`
 	Members []TeamMemberResponse `json:"members,omitempty"`
+
+	// Location is set as for CheckResponse.Location.
+	Location string `json:"-"`
 }
 
 // TeamMemberResponse represents the JSON response for contacts in alerting teams from the Pingdom API.
@@ -141,6 +260,73 @@ type SummaryPerformanceSummary struct {
 	Uptime      int `json:"uptime"`
 }
 
+// SummaryHourOfDay is the average response time of a check for an hour of the day.
+type SummaryHourOfDay struct {
+	Hour        int `json:"hour"`
+	AvgResponse int `json:"avgresponse"`
+}
+
+// SummaryProbes is the list of probes that monitored a check during a time window.
+type SummaryProbes struct {
+	Probes []int
+	From   int64
+	To     int64
+}
+
+// SummaryOutageState is a period during which a check had the same status,
+// as returned by the outage summary.
+type SummaryOutageState struct {
+	Status   string `json:"status"`
+	TimeFrom int64  `json:"timefrom"`
+	TimeTo   int64  `json:"timeto"`
+}
+
+// Outage is a period during which a check was down.
+type Outage struct {
+	From     int64
+	To       int64
+	Duration time.Duration
+}
+
+// References represents the JSON response for the reference data from the Pingdom API.
+type References struct {
+	Regions         []ReferenceRegion    `json:"regions"`
+	Timezones       []ReferenceItem      `json:"timezones"`
+	DateTimeFormats []ReferenceItem      `json:"datetimeformats"`
+	NumberFormats   []ReferenceItem      `json:"numberformats"`
+	Countries       []ReferenceCountry   `json:"countries"`
+	PhoneCodes      []ReferencePhoneCode `json:"phonecodes"`
+}
+
+// ReferenceRegion is a region of the reference data with its default settings.
+type ReferenceRegion struct {
+	ID               int    `json:"id"`
+	Description      string `json:"description"`
+	CountryID        int    `json:"countryid"`
+	DateTimeFormatID int    `json:"datetimeformatid"`
+	NumberFormatID   int    `json:"numberformatid"`
+	TimezoneID       int    `json:"timezoneid"`
+}
+
+// ReferenceItem is a time zone, date time format or number format of the reference data.
+type ReferenceItem struct {
+	ID          int    `json:"id"`
+	Description string `json:"description"`
+}
+
+// ReferenceCountry is a country of the reference data.
+type ReferenceCountry struct {
+	ID  int    `json:"id"`
+	ISO string `json:"iso"`
+}
+
+// ReferencePhoneCode is a phone code of the reference data.
+type ReferencePhoneCode struct {
+	CountryID int    `json:"countryid"`
+	Name      string `json:"name"`
+	PhoneCode string `json:"phonecode"`
+}
+
 // ResultsResponse represents the JSON response for detailed check results from the Pingdom API.
 type ResultsResponse struct {
 	ActiveProbes []int    `json:"activeprobes"`
@@ -157,6 +343,57 @@ type Result struct {
 	StatusDescLong string `json:"statusdesclong"`
 }
 
+// SingleCheckResult represents the JSON response for a single test from the Pingdom API.
+type SingleCheckResult struct {
+	Status         string `json:"status"`
+	ResponseTime   int    `json:"responsetime"`
+	StatusDesc     string `json:"statusdesc"`
+	StatusDescLong string `json:"statusdesclong"`
+	ProbeID        int    `json:"probeid"`
+	ProbeDesc      string `json:"probedesc"`
+}
+
+// TracerouteResult represents the JSON response for a traceroute from the Pingdom API.
+type TracerouteResult struct {
+	Result           string `json:"result"`
+	ProbeID          int    `json:"probeid"`
+	ProbeDescription string `json:"probedescription"`
+}
+
+// Credits represents the JSON response for the credits of the account from
+// the Pingdom API.  SMS credits are only reported for the whole account.
+type Credits struct {
+	CheckLimit          int  `json:"checklimit"`
+	AvailableChecks     int  `json:"availablechecks"`
+	UsedDefault         int  `json:"useddefault"`
+	UsedTransaction     int  `json:"usedtransaction"`
+	AvailableSMS        int  `json:"availablesms"`
+	AvailableSMSTests   int  `json:"availablesmstests"`
+	AutoFillSMS         bool `json:"autofillsms"`
+	AutoFillSMSAmount   int  `json:"autofillsms_amount"`
+	AutoFillSMSWhenLeft int  `json:"autofillsms_when_left"`
+	MaxSMSOverage       int  `json:"max_sms_overage"`
+	AvailableRUMSites   int  `json:"availablerumsites"`
+	UsedRUM             int  `json:"usedrum"`
+	MaxRUMFilters       int  `json:"maxrumfilters"`
+	MaxRUMPageViews     int  `json:"maxrumpageviews"`
+}
+
+// ActionAlert represents the JSON response for an alert sent by Pingdom, as
+// listed by the actions endpoint.
+type ActionAlert struct {
+	ContactName  string `json:"contactname"`
+	ContactID    int    `json:"contactid"`
+	CheckID      int    `json:"checkid"`
+	Time         int64  `json:"time"`
+	Via          string `json:"via"`
+	Status       string `json:"status"`
+	MessageShort string `json:"messageshort"`
+	MessageFull  string `json:"messagefull"`
+	SentTo       string `json:"sentto"`
+	Charged      bool   `json:"charged"`
+}
+
 // UnmarshalJSON converts a byte array into a CheckResponseType.
 func (c *CheckResponseType) UnmarshalJSON(b []byte) error {
 	var raw interface{}
@@ -168,13 +405,13 @@ func (c *CheckResponseType) UnmarshalJSON(b []byte) error {
 
 	switch v := raw.(type) {
 	case string:
-		c.Name = v
+		c.Name = CheckType(v)
 	case map[string]interface{}:
 		if len(v) != 1 {
 			return fmt.Errorf("Check detailed response `check.type` contains more than one object: %+v", v)
 		}
 		for k := range v {
-			c.Name = k
+			c.Name = CheckType(k)
 		}
 
 		// Allow continue use json.Unmarshall using a type != Unmarshaller
@@ -256,6 +493,56 @@ type checkDetailsJSONResponse struct {
 	Check *CheckResponse `json:"check"`
 }
 
+type singleCheckJSONResponse struct {
+	Result *SingleCheckResult `json:"result"`
+}
+
+type summaryHoursOfDayJSONResponse struct {
+	HoursOfDay []SummaryHourOfDay `json:"hoursofday"`
+}
+
+// SummaryAverageStatus is the time, in seconds, a check spent in each state
+// over the window of an average summary.
+type SummaryAverageStatus struct {
+	TotalUp      int64 `json:"totalup"`
+	TotalDown    int64 `json:"totaldown"`
+	TotalUnknown int64 `json:"totalunknown"`
+}
+
+type summaryAverageJSONResponse struct {
+	Summary struct {
+		Status SummaryAverageStatus `json:"status"`
+	} `json:"summary"`
+}
+
+type summaryOutageJSONResponse struct {
+	Summary struct {
+		States []SummaryOutageState `json:"states"`
+	} `json:"summary"`
+}
+
+type summaryProbesJSONResponse struct {
+	Probes []int `json:"probes"`
+}
+
+type serverTimeJSONResponse struct {
+	ServerTime int64 `json:"servertime"`
+}
+
+type creditsJSONResponse struct {
+	Credits *Credits `json:"credits"`
+}
+
+type actionsJSONResponse struct {
+	Actions struct {
+		Alerts []ActionAlert `json:"alerts"`
+	} `json:"actions"`
+}
+
+type tracerouteJSONResponse struct {
+	Traceroute *TracerouteResult `json:"traceroute"`
+}
+
 type maintenanceDetailsJSONResponse struct {
 	Maintenance *MaintenanceResponse `json:"maintenance"`
 }
@@ -294,6 +581,9 @@ type TMSCheckDetailResponse struct {
 	CreatedAt         int64  `json:"created_at,omitempty"`
 	ModifiedAt        int64  `json:"modified_at,omitempty"`
 	Status            string `json:"status,omitempty"`
+
+	// Location is set as for CheckResponse.Location.
+	Location string `json:"-"`
 }
 type TMSCheckStatusReportResponse struct {
 	CheckID int              `json:"check_id,omitempty"`
@@ -3,6 +3,7 @@ package pingdom
 import (
 	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -29,6 +30,7 @@ var detailedCheckJSON = `
 	"hostname" : "s7.mydomain.com",
 	"status" : "up",
 	"severity_level": "HIGH",
+	"custom_message": "Runbook: https://wiki.example.com/runbooks/s7",
 	"lasterrortime" : 1293143467,
 	"lasttesttime" : 1294064823,
 	"tags": [],
@@ -46,10 +48,11 @@ func TestCheckResponseUnmarshal(t *testing.T) {
 	var ck CheckResponse
 	err := json.Unmarshal([]byte(detailedCheckJSON), &ck)
 	assert.NoError(t, err)
-	assert.Equal(t, "http", ck.Type.Name)
+	assert.Equal(t, CheckTypeHTTP, ck.Type.Name)
 	assert.NotNil(t, ck.Type.HTTP)
 	assert.Equal(t, 2, len(ck.Type.HTTP.RequestHeaders))
 	assert.Equal(t, "HIGH", ck.SeverityLevel)
+	assert.Equal(t, "Runbook: https://wiki.example.com/runbooks/s7", ck.CustomMessage)
 }
 
 var detailedDNSCheckJSON = `
@@ -86,7 +89,7 @@ func TestDNSCheckResponseUnmarshal(t *testing.T) {
 	err := json.Unmarshal([]byte(detailedDNSCheckJSON), &ck)
 	assert.NoError(t, err)
 	assert.Equal(t, true, ck.IPv6)
-	assert.Equal(t, "dns", ck.Type.Name)
+	assert.Equal(t, CheckTypeDNS, ck.Type.Name)
 	assert.NotNil(t, ck.Type.DNS)
 	assert.Equal(t, "2606:2800:220:1:248:1893:25c8:1946", ck.Type.DNS.ExpectedIP)
 	assert.Equal(t, "a.iana-servers.net", ck.Type.DNS.NameServer)
@@ -177,3 +180,119 @@ func TestCheckContactUnmarshal(t *testing.T) {
 	assert.NotNil(t, contact.ID)
 	assert.Equal(t, expectedNotificationTargets, contact.NotificationTargets)
 }
+
+func TestCheckResponseHasRecipients(t *testing.T) {
+	tests := []struct {
+		name  string
+		check CheckResponse
+		want  bool
+	}{
+		{name: "no recipients", check: CheckResponse{}, want: false},
+		{name: "users", check: CheckResponse{UserIds: []int{1}}, want: true},
+		{name: "teams", check: CheckResponse{Teams: []CheckTeamResponse{{ID: 1}}}, want: true},
+		{name: "team ids", check: CheckResponse{TeamIds: []int{1}}, want: true},
+		{name: "legacy contacts", check: CheckResponse{ContactIds: []int{1}}, want: true},
+		{name: "integrations", check: CheckResponse{IntegrationIds: []int{1}}, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.check.HasRecipients())
+		})
+	}
+}
+
+func TestCheckResponseSSLUnmarshal(t *testing.T) {
+	var ck CheckResponse
+	err := json.Unmarshal([]byte(`{
+		"id": 85975,
+		"type": {
+			"http": {
+				"url": "/",
+				"encryption": true,
+				"port": 443,
+				"verify_certificate": true,
+				"ssl_down_days_before": 14
+			}
+		}
+	}`), &ck)
+	assert.NoError(t, err)
+	assert.True(t, ck.Type.HTTP.VerifyCertificate)
+	assert.Equal(t, 14, ck.Type.HTTP.SSLDownDaysBefore)
+}
+
+func TestCheckResponseTypeUnmarshal(t *testing.T) {
+	types := []CheckType{
+		CheckTypeHTTP,
+		CheckTypeHTTPCustom,
+		CheckTypeTCP,
+		CheckTypeUDP,
+		CheckTypePing,
+		CheckTypeDNS,
+		CheckTypeSMTP,
+		CheckTypeIMAP,
+		CheckTypePOP3,
+	}
+
+	for _, want := range types {
+		t.Run(string(want), func(t *testing.T) {
+			// Check lists return the type as a string, check details as an
+			// object keyed by type.
+			for _, body := range []string{`"` + string(want) + `"`, `{"` + string(want) + `": {}}`} {
+				var ct CheckResponseType
+				assert.NoError(t, json.Unmarshal([]byte(body), &ct))
+				assert.Equal(t, want, ct.Name)
+			}
+		})
+	}
+}
+
+func TestCheckResponseNotificationModel(t *testing.T) {
+	var legacy, modern CheckResponse
+	err := json.Unmarshal([]byte(`{"id": 1, "name": "legacy", "contactids": [111, 222]}`), &legacy)
+	assert.NoError(t, err)
+	err = json.Unmarshal([]byte(`{"id": 2, "name": "modern", "userids": [333], "teams": [{"id": 444, "name": "ops"}]}`), &modern)
+	assert.NoError(t, err)
+
+	assert.Equal(t, []int{111, 222}, legacy.ContactIds)
+	assert.Equal(t, NotificationModelLegacy, legacy.NotificationModel())
+	assert.Equal(t, NotificationModelModern, modern.NotificationModel())
+	assert.Equal(t, NotificationModelNone, (&CheckResponse{}).NotificationModel())
+
+	mixed := CheckResponse{ContactIds: []int{111}, UserIds: []int{333}}
+	assert.Equal(t, NotificationModelLegacy, mixed.NotificationModel())
+}
+
+func TestCheckStatusUnmarshal(t *testing.T) {
+	tests := []struct {
+		json  string
+		want  CheckStatus
+		known bool
+	}{
+		{`{"status":"up"}`, StatusUp, true},
+		{`{"status":"unconfirmed_down"}`, StatusUnconfirmedDown, true},
+		{`{"status":"degraded"}`, CheckStatus("degraded"), false},
+		{`{"status":null}`, "", false},
+		{`{"status":3}`, "", false},
+	}
+	for _, tt := range tests {
+		var check CheckResponse
+		assert.NoError(t, json.Unmarshal([]byte(tt.json), &check), tt.json)
+		assert.Equal(t, tt.want, check.Status, tt.json)
+		assert.Equal(t, tt.known, check.Status.Known(), tt.json)
+	}
+
+	b, err := json.Marshal(CheckResponse{ID: 1, Status: StatusPaused})
+	assert.NoError(t, err)
+	assert.Contains(t, string(b), `"status":"paused"`)
+}
+
+func TestCheckResponseModifiedAt(t *testing.T) {
+	var ck CheckResponse
+	err := json.Unmarshal([]byte(`{"id":1,"name":"web","lastmodified":1563386400}`), &ck)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1563386400), ck.LastModified)
+	assert.Equal(t, time.Date(2019, 7, 17, 18, 0, 0, 0, time.UTC), ck.ModifiedAt())
+
+	assert.True(t, (&CheckResponse{}).ModifiedAt().IsZero())
+}
@@ -1,9 +1,18 @@
 package pingdom
 
 import (
-	"encoding/json"
-	"io/ioutil"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
 	"strconv"
+	"strings"
+	"sync"
+	"time"
 )
 
 // CheckService provides an interface to Pingdom checks.
@@ -32,29 +41,76 @@ func (cs *CheckService) List(params ...map[string]string) ([]CheckResponse, erro
 		return nil, err
 	}
 
-	resp, err := cs.client.client.Do(req)
-	if err != nil {
+	m := &listChecksJSONResponse{}
+	if _, err := cs.client.doStream(req, m); err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
+	return m.Checks, nil
+}
 
-	if err := validateResponse(resp); err != nil {
+// ListWithQuery returns the checks matching the given query.  The optional
+// overrides are raw params merged over those of the query, for params the
+// query does not model yet.
+func (cs *CheckService) ListWithQuery(query CheckListQuery, overrides ...map[string]string) ([]CheckResponse, error) {
+	if err := query.Valid(); err != nil {
 		return nil, err
 	}
 
-	bodyBytes, _ := ioutil.ReadAll(resp.Body)
-	bodyString := string(bodyBytes)
-	m := &listChecksJSONResponse{}
-	err = json.Unmarshal([]byte(bodyString), &m)
+	return cs.List(mergeParams(append([]map[string]string{query.GetParams()}, overrides...)...))
+}
+
+// ListModifiedSince returns the checks modified at or after t, e.g. to sync
+// checks incrementally.  The checks endpoint cannot filter on the
+// modification time, so all checks are listed and filtered here.  Pingdom
+// records it to the second, so checks modified in the second of t are
+// returned again by the next sync, and checks whose modification time
+// Pingdom did not return are always kept: every change is returned at least
+// once.
+func (cs *CheckService) ListModifiedSince(t time.Time) ([]CheckResponse, error) {
+	checks, err := cs.List()
+	if err != nil {
+		return nil, err
+	}
+
+	since := t.Unix()
+	modified := []CheckResponse{}
+	for _, check := range checks {
+		if check.LastModified == 0 || check.LastModified >= since {
+			modified = append(modified, check)
+		}
+	}
+	return modified, nil
+}
+
+// ListByStatus returns the checks with the given status, one of up, down,
+// unconfirmed_down, unknown or paused.  The checks endpoint cannot filter by
+// status, so all checks are listed and filtered here.  The request is bound to
+// ctx, so that a poll can be cancelled.
+func (cs *CheckService) ListByStatus(ctx context.Context, status CheckStatus) ([]CheckResponse, error) {
+	if !status.Known() {
+		return nil, fmt.Errorf("invalid value %q for `status`, must be one of up, down, unconfirmed_down, unknown or paused", status)
+	}
+
+	checks, err := cs.client.WithContext(ctx).Checks.List()
+	if err != nil {
+		return nil, err
+	}
 
-	return m.Checks, err
+	matching := []CheckResponse{}
+	for _, check := range checks {
+		if check.Status == status {
+			matching = append(matching, check)
+		}
+	}
+	return matching, nil
 }
 
 // Create a new check. This function will validate the given check param
 // to ensure that it contains correct values before submitting the request
 // Returns a CheckResponse object representing the response from Pingdom.
 // Note that Pingdom does not return a full check object so in the returned
-// object you should only use the ID field.
+// object you should only use the ID field.  An invalid check is rejected
+// with a *ValidationError before any request is sent.
 func (cs *CheckService) Create(check Check) (*CheckResponse, error) {
 	if err := check.Valid(); err != nil {
 		return nil, err
@@ -66,13 +122,202 @@ func (cs *CheckService) Create(check Check) (*CheckResponse, error) {
 	}
 
 	m := &checkDetailsJSONResponse{}
-	_, err = cs.client.Do(req, m)
+	resp, err := cs.client.Do(req, m)
 	if err != nil {
 		return nil, err
 	}
+	if m.Check != nil {
+		m.Check.Location = location(resp)
+	}
 	return m.Check, err
 }
 
+// minRegionProbes is the least number of active probes the regions of a
+// region constrained check must hold, so that Pingdom can confirm an outage
+// from other probes of the same regions.
+const minRegionProbes = 3
+
+// regionCheck is a check whose probes are restricted to a set of regions.
+type regionCheck struct {
+	Check
+	probeFilters string
+}
+
+func (c regionCheck) PutParams() map[string]string {
+	params := c.Check.PutParams()
+	params["probe_filters"] = c.probeFilters
+	return params
+}
+
+func (c regionCheck) PostParams() map[string]string {
+	params := c.Check.PostParams()
+	params["probe_filters"] = c.probeFilters
+	return params
+}
+
+// CreateWithRegions creates a new check whose probes are restricted to the
+// given regions, such as "EU" or "NA".  It overrides the probe filters of the
+// check, and fails without creating the check when the regions hold fewer
+// active probes than Pingdom needs to confirm outages.
+func (cs *CheckService) CreateWithRegions(check Check, regions []string) (*CheckResponse, error) {
+	if len(regions) == 0 {
+		return nil, fmt.Errorf("invalid value for `regions`, must contain at least one region")
+	}
+	if err := check.Valid(); err != nil {
+		return nil, err
+	}
+
+	probes, err := cs.client.Probes.List(map[string]string{"onlyactive": "true"})
+	if err != nil {
+		return nil, err
+	}
+
+	wanted := map[string]bool{}
+	filters := make([]string, len(regions))
+	for i, region := range regions {
+		region = strings.ToUpper(strings.TrimSpace(region))
+		wanted[region] = true
+		filters[i] = "region: " + region
+	}
+
+	count := 0
+	for _, probe := range probes {
+		if probe.Active && wanted[strings.ToUpper(probe.Region)] {
+			count++
+		}
+	}
+	if count < minRegionProbes {
+		return nil, fmt.Errorf("regions %v hold %d active probes, at least %d are required", regions, count, minRegionProbes)
+	}
+
+	return cs.Create(regionCheck{Check: check, probeFilters: strings.Join(filters, ",")})
+}
+
+// CreateIdempotent creates a new check like Create, but is safe to retry
+// when the response to the create is lost.  Creates are not retried by the
+// client's RetryPolicy, since Pingdom may have created the check anyway;
+// instead, CreateIdempotent retries a create that failed with a network
+// error, a 429 or a 5xx response, at least once and up to MaxRetries times,
+// after looking for a check with the same name and hostname.  When one
+// exists, or Pingdom rejects the create as a duplicate, that check is
+// returned instead of creating another.
+func (cs *CheckService) CreateIdempotent(check Check) (*CheckResponse, error) {
+	if err := check.Valid(); err != nil {
+		return nil, err
+	}
+
+	params := check.PostParams()
+	name, hostname := params["name"], params["host"]
+
+	retries := cs.client.retry.MaxRetries
+	if retries < 1 {
+		retries = 1
+	}
+	for attempt := 0; ; attempt++ {
+		created, err := cs.Create(check)
+		if err == nil {
+			return created, nil
+		}
+		if errors.Is(err, ErrDuplicateName) {
+			if existing, ferr := cs.findByNameAndHost(name, hostname); ferr == nil && existing != nil {
+				return existing, nil
+			}
+			return nil, err
+		}
+		if attempt >= retries || !isRetryableCreate(err) {
+			return nil, err
+		}
+
+		timer := time.NewTimer(cs.client.retry.backoff(attempt))
+		select {
+		case <-cs.client.requestContext().Done():
+			timer.Stop()
+			return nil, cs.client.requestContext().Err()
+		case <-timer.C:
+		}
+
+		existing, ferr := cs.findByNameAndHost(name, hostname)
+		if ferr != nil {
+			return nil, ferr
+		}
+		if existing != nil {
+			return existing, nil
+		}
+	}
+}
+
+// isRetryableCreate reports whether a failed create may have been lost,
+// either to a network error or to a 429 or 5xx response.
+func isRetryableCreate(err error) bool {
+	var pe *PingdomError
+	if errors.As(err, &pe) {
+		return pe.StatusCode == http.StatusTooManyRequests || pe.StatusCode >= 500
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	var ue *url.Error
+	return errors.As(err, &ue)
+}
+
+// findByNameAndHost returns the check with the given name and hostname, or
+// nil when there is none.
+func (cs *CheckService) findByNameAndHost(name, hostname string) (*CheckResponse, error) {
+	checks, err := cs.List()
+	if err != nil {
+		return nil, err
+	}
+	for i := range checks {
+		if checks[i].Name == name && checks[i].Hostname == hostname {
+			return &checks[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// singleParams are the check parameters understood by the /single endpoint.
+var singleParams = map[string]bool{
+	"host":             true,
+	"type":             true,
+	"ipv6":             true,
+	"url":              true,
+	"encryption":       true,
+	"port":             true,
+	"auth":             true,
+	"shouldcontain":    true,
+	"shouldnotcontain": true,
+	"postdata":         true,
+	"stringtosend":     true,
+	"stringtoexpect":   true,
+	"expectedip":       true,
+	"nameserver":       true,
+}
+
+// VerifyReachable runs a single test with the parameters of the given check,
+// without creating it, and reports whether the check would be up.
+// This can be used to catch configuration errors before creating a check.
+func (cs *CheckService) VerifyReachable(check Check) (bool, *SingleCheckResult, error) {
+	if err := check.Valid(); err != nil {
+		return false, nil, err
+	}
+
+	params := map[string]string{}
+	for k, v := range check.PostParams() {
+		if singleParams[k] || strings.HasPrefix(k, "requestheader") {
+			params[k] = v
+		}
+	}
+
+	result, err := cs.client.SingleChecks.Run(params["host"], CheckType(params["type"]), params)
+	if err != nil {
+		return false, nil, err
+	}
+	if result == nil {
+		return false, nil, ErrEmptyResponse
+	}
+	return result.Status == "up", result, nil
+}
+
 // ReadCheck returns detailed information about a pingdom check given its ID.
 // This returns type CheckResponse rather than Check since the
 // pingdom API does not return a complete representation of a check.
@@ -87,6 +332,9 @@ func (cs *CheckService) Read(id int) (*CheckResponse, error) {
 	if err != nil {
 		return nil, err
 	}
+	if m.Check == nil {
+		return nil, ErrEmptyResponse
+	}
 	m.Check.TeamIds = make([]int, len(m.Check.Teams))
 	for i := range m.Check.Teams {
 		m.Check.TeamIds[i] = m.Check.Teams[i].ID
@@ -95,9 +343,86 @@ func (cs *CheckService) Read(id int) (*CheckResponse, error) {
 	return m.Check, err
 }
 
+// readMultiWorkers bounds the number of concurrent requests made by ReadMulti.
+const readMultiWorkers = 4
+
+// ReadMultiError holds the errors of a ReadMulti call, by check ID.
+type ReadMultiError map[int]error
+
+func (e ReadMultiError) Error() string {
+	ids := make([]int, 0, len(e))
+	for id := range e {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+
+	msgs := make([]string, len(ids))
+	for i, id := range ids {
+		msgs[i] = fmt.Sprintf("check %d: %v", id, e[id])
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// ReadMulti returns detailed information about several checks.  The Pingdom
+// API has no batch endpoint, so the checks are read concurrently with a
+// bounded number of requests in flight, going through the client's retry
+// policy.  Checks that could not be read are missing from the returned map
+// and their errors are returned as a ReadMultiError.
+func (cs *CheckService) ReadMulti(ids []int) (map[int]*CheckResponse, error) {
+	type result struct {
+		id    int
+		check *CheckResponse
+		err   error
+	}
+
+	jobs := make(chan int)
+	results := make(chan result)
+
+	workers := readMultiWorkers
+	if len(ids) < workers {
+		workers = len(ids)
+	}
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for id := range jobs {
+				check, err := cs.Read(id)
+				results <- result{id: id, check: check, err: err}
+			}
+		}()
+	}
+
+	go func() {
+		for _, id := range ids {
+			jobs <- id
+		}
+		close(jobs)
+		wg.Wait()
+		close(results)
+	}()
+
+	checks := make(map[int]*CheckResponse, len(ids))
+	errs := ReadMultiError{}
+	for r := range results {
+		if r.err != nil {
+			errs[r.id] = r.err
+			continue
+		}
+		checks[r.id] = r.check
+	}
+
+	if len(errs) != 0 {
+		return checks, errs
+	}
+	return checks, nil
+}
+
 // Update will update the check represented by the given ID with the values
 // in the given check.  You should submit the complete list of values in
-// the given check parameter, not just those that have changed.
+// the given check parameter, not just those that have changed.  An invalid
+// check is rejected with a *ValidationError before any request is sent.
 func (cs *CheckService) Update(id int, check Check) (*PingdomResponse, error) {
 	if err := check.Valid(); err != nil {
 		return nil, err
@@ -116,6 +441,221 @@ func (cs *CheckService) Update(id int, check Check) (*PingdomResponse, error) {
 	return m, err
 }
 
+// Pause pauses the check for the given ID, leaving its other settings
+// untouched.
+func (cs *CheckService) Pause(id int) (*PingdomResponse, error) {
+	return cs.setPaused(id, true)
+}
+
+// Unpause resumes the check for the given ID, leaving its other settings
+// untouched.
+func (cs *CheckService) Unpause(id int) (*PingdomResponse, error) {
+	return cs.setPaused(id, false)
+}
+
+func (cs *CheckService) setPaused(id int, paused bool) (*PingdomResponse, error) {
+	params := map[string]string{"paused": strconv.FormatBool(paused)}
+	req, err := cs.client.NewRequest("PUT", "/checks/"+strconv.Itoa(id), params)
+	if err != nil {
+		return nil, err
+	}
+
+	m := &PingdomResponse{}
+	_, err = cs.client.Do(req, m)
+	if err != nil {
+		return nil, err
+	}
+	return m, err
+}
+
+// SuppressUntil creates a maintenance window covering the check for the
+// given ID from now until the given time.  Unlike Pause, which stops the
+// check from running, the check keeps collecting results during the window
+// while its alerts are suppressed, so uptime reports have no gaps.
+func (cs *CheckService) SuppressUntil(id int, until time.Time) (*MaintenanceResponse, error) {
+	now := cs.client.now()
+	if !until.After(now) {
+		return nil, fmt.Errorf("invalid value %v for `until`, must be in the future", until)
+	}
+
+	return cs.client.Maintenances.Create(&MaintenanceWindow{
+		Description: fmt.Sprintf("Alerts suppressed for check %d", id),
+		From:        now.Unix(),
+		To:          until.Unix(),
+		UptimeIDs:   strconv.Itoa(id),
+	})
+}
+
+// PauseMulti pauses the checks with the given IDs in a single request.
+func (cs *CheckService) PauseMulti(ids []int) (*PingdomResponse, error) {
+	return cs.setPausedMulti(ids, true)
+}
+
+// UnpauseMulti resumes the checks with the given IDs in a single request.
+func (cs *CheckService) UnpauseMulti(ids []int) (*PingdomResponse, error) {
+	return cs.setPausedMulti(ids, false)
+}
+
+func (cs *CheckService) setPausedMulti(ids []int, paused bool) (*PingdomResponse, error) {
+	return cs.ModifyMulti(ids, map[string]string{"paused": strconv.FormatBool(paused)})
+}
+
+// bulkParams are the params PUT /checks accepts to modify several checks.
+var bulkParams = map[string]bool{
+	"paused":     true,
+	"resolution": true,
+	"tags":       true,
+}
+
+// ModifyMulti sets the given params on all the checks with the given IDs in a
+// single request.  Only the params Pingdom can change in bulk are accepted:
+// paused, resolution and tags.  Other settings, such as notifications, must be
+// changed one check at a time with Update.
+func (cs *CheckService) ModifyMulti(ids []int, params map[string]string) (*PingdomResponse, error) {
+	// Without checkids, Pingdom would modify every check of the account.
+	if len(ids) == 0 {
+		return nil, fmt.Errorf("invalid value for `ids`, must contain at least one check ID")
+	}
+	if len(params) == 0 {
+		return nil, fmt.Errorf("invalid value for `params`, must contain at least one param")
+	}
+	for k := range params {
+		if !bulkParams[k] {
+			return nil, fmt.Errorf("invalid param %q, cannot be modified on several checks at once", k)
+		}
+	}
+
+	req, err := cs.client.NewRequest("PUT", "/checks", mergeParams(params, map[string]string{
+		"checkids": intListToCDString(ids),
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	m := &PingdomResponse{}
+	_, err = cs.client.Do(req, m)
+	if err != nil {
+		return nil, err
+	}
+	return m, err
+}
+
+// SetTagsMany sets the tags of the checks with the given IDs in a single
+// request.  The tags replace the existing tags of every check rather than
+// being added to them; passing no tags removes all of them.
+func (cs *CheckService) SetTagsMany(ids []int, tags []string) (*PingdomResponse, error) {
+	joined := strings.Join(tags, ",")
+	if err := validTags(joined); err != nil {
+		return nil, err
+	}
+
+	return cs.ModifyMulti(ids, map[string]string{"tags": normalizeTags(joined)})
+}
+
+// AddTags adds the given tags to the check for the given ID, keeping its
+// current tags.
+func (cs *CheckService) AddTags(id int, tags []string) (*PingdomResponse, error) {
+	joined, err := tagList(tags)
+	if err != nil {
+		return nil, err
+	}
+	return cs.modifyTags(id, map[string]string{"addtags": joined})
+}
+
+// RemoveTags removes the given tags from the check for the given ID, keeping
+// its other tags.  Tags the check does not have are ignored.
+func (cs *CheckService) RemoveTags(id int, tags []string) (*PingdomResponse, error) {
+	joined, err := tagList(tags)
+	if err != nil {
+		return nil, err
+	}
+
+	check, err := cs.Read(id)
+	if err != nil {
+		return nil, err
+	}
+	var removed TagSet
+	for _, name := range strings.Split(joined, ",") {
+		removed = append(removed, CheckResponseTag{Name: name})
+	}
+	kept := check.Tags.Difference(removed)
+	return cs.modifyTags(id, map[string]string{"tags": strings.Join(kept.Names(), ",")})
+}
+
+func (cs *CheckService) modifyTags(id int, params map[string]string) (*PingdomResponse, error) {
+	req, err := cs.client.NewRequest("PUT", "/checks/"+strconv.Itoa(id), params)
+	if err != nil {
+		return nil, err
+	}
+
+	m := &PingdomResponse{}
+	_, err = cs.client.Do(req, m)
+	if err != nil {
+		return nil, err
+	}
+	return m, err
+}
+
+// MigrateNotifications moves the check for the given ID from the legacy
+// notification model to the modern one: its legacy contacts are replaced by
+// the matching users, added to the users it already alerts.  Every legacy
+// contact must be a user contact; otherwise nothing is changed and an error is
+// returned.  A check not using legacy contacts is left untouched, with a nil
+// response.
+func (cs *CheckService) MigrateNotifications(id int) (*PingdomResponse, error) {
+	check, err := cs.Read(id)
+	if err != nil {
+		return nil, err
+	}
+	if len(check.ContactIds) == 0 {
+		return nil, nil
+	}
+
+	userIDs := append([]int{}, check.UserIds...)
+	seen := make(map[int]bool, len(userIDs))
+	for _, u := range userIDs {
+		seen[u] = true
+	}
+	for _, contactID := range check.ContactIds {
+		contact, err := cs.client.Contacts.Read(contactID)
+		if errors.Is(err, ErrNotFound) {
+			return nil, fmt.Errorf("contact %d of check %d has no user equivalent: %w", contactID, id, err)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if contact.Type != "user" {
+			return nil, fmt.Errorf("contact %d of check %d is of type %q, not a user", contactID, id, contact.Type)
+		}
+		if !seen[contact.ID] {
+			seen[contact.ID] = true
+			userIDs = append(userIDs, contact.ID)
+		}
+	}
+
+	params := map[string]string{
+		"contactids": "",
+		"userids":    intListToCDString(userIDs),
+	}
+	req, err := cs.client.NewRequest("PUT", "/checks/"+strconv.Itoa(id), params)
+	if err != nil {
+		return nil, err
+	}
+
+	m := &PingdomResponse{}
+	_, err = cs.client.Do(req, m)
+	if err != nil {
+		return nil, err
+	}
+	return m, err
+}
+
+// Age returns how long the check has existed, measured with the client's
+// clock.
+func (cs *CheckService) Age(check *CheckResponse) time.Duration {
+	return check.Age(cs.client.now())
+}
+
 // Delete will delete the check for the given ID.
 func (cs *CheckService) Delete(id int) (*PingdomResponse, error) {
 	req, err := cs.client.NewRequest("DELETE", "/checks/"+strconv.Itoa(id), nil)
@@ -132,12 +672,14 @@ func (cs *CheckService) Delete(id int) (*PingdomResponse, error) {
 }
 
 // SummaryPerformance returns a performance summary from Pingdom.
+// When `From` or `To` is not given, the client's default time window is used.
 func (cs *CheckService) SummaryPerformance(request SummaryPerformanceRequest) (*SummaryPerformanceResponse, error) {
 	if err := request.Valid(); err != nil {
 		return nil, err
 	}
 
-	req, err := cs.client.NewRequest("GET", "/summary.performance/"+strconv.Itoa(request.Id), request.GetParams())
+	params := cs.client.withDefaultWindow(request.GetParams())
+	req, err := cs.client.NewRequest("GET", "/summary.performance/"+strconv.Itoa(request.Id), params)
 	if err != nil {
 		return nil, err
 	}
@@ -151,30 +693,184 @@ func (cs *CheckService) SummaryPerformance(request SummaryPerformanceRequest) (*
 }
 
 // Results returns raw check results and the list of associated probe IDs used from Pingdom.
+// When `from` or `to` is not given, the client's default time window is used.
+// The `status` param filters the results by comma separated statuses among
+// up, down, unconfirmed and unknown, e.g. "down,unconfirmed".
 func (cs *CheckService) Results(id int, params ...map[string]string) (*ResultsResponse, error) {
 	param := map[string]string{}
 	if len(params) == 1 {
 		param = params[0]
 	}
+	if status, ok := param["status"]; ok {
+		if err := validResultsStatus(status); err != nil {
+			return nil, err
+		}
+	}
+	param = cs.client.withDefaultWindow(param)
 	req, err := cs.client.NewRequest("GET", "/results/"+strconv.Itoa(id), param)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := cs.client.client.Do(req)
+	m := &ResultsResponse{}
+	if _, err := cs.client.doStream(req, m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// ResultsByCountry returns the results of a check recorded by the active
+// probes of a country, given by name or ISO code such as "Germany" or "DE".
+// The active probes are listed once per call, and those of the country are
+// passed as the `probes` filter, overriding any in params.
+func (cs *CheckService) ResultsByCountry(id int, country string, params ...map[string]string) (*ResultsResponse, error) {
+	country = strings.TrimSpace(country)
+	if country == "" {
+		return nil, fmt.Errorf("invalid value for `country`, must contain non-empty string")
+	}
+
+	probes, err := cs.client.Probes.List(map[string]string{"onlyactive": "true"})
 	if err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
 
-	if err := validateResponse(resp); err != nil {
+	ids := []string{}
+	for _, probe := range probes {
+		if probe.Active && (strings.EqualFold(probe.Country, country) || strings.EqualFold(probe.CountryISO, country)) {
+			ids = append(ids, strconv.Itoa(probe.ID))
+		}
+	}
+	if len(ids) == 0 {
+		return nil, fmt.Errorf("no active probes in country %q", country)
+	}
+
+	param := map[string]string{}
+	if len(params) == 1 {
+		param = params[0]
+	}
+	return cs.Results(id, mergeParams(param, map[string]string{"probes": strings.Join(ids, ",")}))
+}
+
+// LastError returns the most recent failed result of a check, or nil when
+// there is none in the client's default time window.  Use its
+// HTTPStatusCode method to tell an HTTP error from a timeout.
+func (cs *CheckService) LastError(id int) (*Result, error) {
+	results, err := cs.Results(id, map[string]string{
+		"status": "down",
+		"limit":  "1",
+	})
+	if err != nil {
 		return nil, err
 	}
 
-	bodyBytes, _ := ioutil.ReadAll(resp.Body)
-	bodyString := string(bodyBytes)
-	m := &ResultsResponse{}
-	err = json.Unmarshal([]byte(bodyString), &m)
+	if len(results.Results) == 0 {
+		return nil, nil
+	}
+	return &results.Results[0], nil
+}
 
-	return m, err
+// maxResultsLimit is the largest number of results the results endpoint
+// returns in a single page.
+const maxResultsLimit = 1000
+
+// WriteResultsCSV writes the raw results of a check as CSV to w, with a
+// header row and one row per result holding its time in RFC 3339 format,
+// probe ID, status and response time, most recent first.  The results are
+// fetched and written a page at a time, so long periods are not held in
+// memory.  The params are those of Results, except limit and offset which
+// are used for paging.  The time window is fixed before the first page, so
+// that pages do not overlap.  Paging stops once Pingdom rejects an offset
+// beyond the maximum it allows.
+func (cs *CheckService) WriteResultsCSV(id int, params map[string]string, w io.Writer) error {
+	param := cs.client.withDefaultWindow(params)
+	param["limit"] = strconv.Itoa(maxResultsLimit)
+
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{"time", "probeid", "status", "responsetime"}); err != nil {
+		return err
+	}
+
+	for offset := 0; ; offset += maxResultsLimit {
+		param["offset"] = strconv.Itoa(offset)
+		page, err := cs.Results(id, param)
+		if err != nil {
+			if offset > 0 && isOffsetOutOfRange(err) {
+				return nil
+			}
+			return err
+		}
+
+		for _, r := range page.Results {
+			err := cw.Write([]string{
+				time.Unix(int64(r.Time), 0).UTC().Format(time.RFC3339),
+				strconv.Itoa(r.ProbeID),
+				r.Status,
+				strconv.Itoa(r.ResponseTime),
+			})
+			if err != nil {
+				return err
+			}
+		}
+		cw.Flush()
+		if err := cw.Error(); err != nil {
+			return err
+		}
+
+		if len(page.Results) < maxResultsLimit {
+			return nil
+		}
+	}
+}
+
+// WatchMany polls the given checks every interval and calls onChange with the
+// check whenever its status differs from the previous poll.  Each poll makes a
+// single List call bound to ctx, so that cancelling ctx aborts a poll in
+// flight.  The first poll only records the initial statuses.  WatchMany
+// blocks until the context is done, or a poll fails, and returns the
+// corresponding error.  The interval must be positive.
+func (cs *CheckService) WatchMany(ctx context.Context, checkIDs []int, interval time.Duration, onChange func(CheckResponse)) error {
+	if interval <= 0 {
+		return fmt.Errorf("invalid value for `interval`, must be positive")
+	}
+
+	watched := make(map[int]bool, len(checkIDs))
+	for _, id := range checkIDs {
+		watched[id] = true
+	}
+
+	checkService := cs.client.WithContext(ctx).Checks
+	statuses := map[int]CheckStatus{}
+	poll := func() error {
+		checks, err := checkService.List()
+		if err != nil {
+			return err
+		}
+		for _, check := range checks {
+			if !watched[check.ID] {
+				continue
+			}
+			previous, seen := statuses[check.ID]
+			statuses[check.ID] = check.Status
+			if seen && previous != check.Status {
+				onChange(check)
+			}
+		}
+		return nil
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := poll(); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
 }
@@ -0,0 +1,171 @@
+package pingdom
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// CheckDrift is a parameter whose live value differs from the desired one.
+type CheckDrift struct {
+	Param   string
+	Live    string
+	Desired string
+}
+
+// CheckDriftError is returned by AssertMatches when a live check differs
+// from the desired configuration.
+type CheckDriftError struct {
+	CheckID int
+	Drifts  []CheckDrift
+}
+
+func (e *CheckDriftError) Error() string {
+	diffs := make([]string, len(e.Drifts))
+	for i, d := range e.Drifts {
+		diffs[i] = fmt.Sprintf("%s is %q, want %q", d.Param, d.Live, d.Desired)
+	}
+	return fmt.Sprintf("check %d drifted: %s", e.CheckID, strings.Join(diffs, "; "))
+}
+
+// listParams are the check parameters holding comma separated lists, which
+// are compared regardless of order.
+var listParams = map[string]bool{
+	"integrationids": true,
+	"probe_filters":  true,
+	"tags":           true,
+	"teamids":        true,
+	"userids":        true,
+}
+
+// Diff reads the check with the given ID and returns the parameters of
+// desired whose live value differs, sorted by parameter name.  Parameters
+// left empty in desired, and parameters the API does not return, such as
+// auth or request headers, are not compared.
+func (cs *CheckService) Diff(id int, desired Check) ([]CheckDrift, error) {
+	live, err := cs.Read(id)
+	if err != nil {
+		return nil, err
+	}
+
+	liveParams := live.params()
+	drifts := []CheckDrift{}
+	for k, want := range desired.PostParams() {
+		got, ok := liveParams[k]
+		if !ok {
+			continue
+		}
+		if listParams[k] {
+			got, want = sortedList(got), sortedList(want)
+		}
+		if got != want {
+			drifts = append(drifts, CheckDrift{Param: k, Live: got, Desired: want})
+		}
+	}
+	sort.Slice(drifts, func(i, j int) bool { return drifts[i].Param < drifts[j].Param })
+	return drifts, nil
+}
+
+// AssertMatches reads the check with the given ID and returns a
+// *CheckDriftError when it differs from desired, as described by Diff.  It
+// returns nil when the check matches, which makes it usable as a CI gate.
+func (cs *CheckService) AssertMatches(id int, desired Check) error {
+	drifts, err := cs.Diff(id, desired)
+	if err != nil {
+		return err
+	}
+	if len(drifts) != 0 {
+		return &CheckDriftError{CheckID: id, Drifts: drifts}
+	}
+	return nil
+}
+
+// HashCheck returns a stable hash of the settings of check, as sent to create
+// it, to cheaply tell whether a desired configuration changed.  The settings
+// are hashed as canonical JSON, with sorted keys and lists compared
+// regardless of order, so that equivalent checks hash identically.
+func HashCheck(check Check) string {
+	params := check.PostParams()
+	for k := range listParams {
+		if v, ok := params[k]; ok {
+			params[k] = sortedList(v)
+		}
+	}
+
+	// encoding/json sorts map keys, which makes the encoding canonical, and
+	// cannot fail on a map of strings.
+	b, _ := json.Marshal(params)
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
+
+// params returns the check as the parameters used to write it, for the
+// fields returned by the API.
+func (c *CheckResponse) params() map[string]string {
+	teamIDs := c.TeamIds
+	if len(teamIDs) == 0 {
+		for _, t := range c.Teams {
+			teamIDs = append(teamIDs, t.ID)
+		}
+	}
+
+	m := map[string]string{
+		"host":                     c.Hostname,
+		"integrationids":           intListToCDString(c.IntegrationIds),
+		"ipv6":                     strconv.FormatBool(c.IPv6),
+		"name":                     c.Name,
+		"notifyagainevery":         strconv.Itoa(c.NotifyAgainEvery),
+		"notifywhenbackup":         strconv.FormatBool(c.NotifyWhenBackup),
+		"paused":                   strconv.FormatBool(c.Paused),
+		"probe_filters":            strings.Join(c.ProbeFilters, ","),
+		"resolution":               strconv.Itoa(c.Resolution),
+		"responsetime_threshold":   strconv.Itoa(c.ResponseTimeThreshold),
+		"sendnotificationwhendown": strconv.Itoa(c.SendNotificationWhenDown),
+		"tags":                     strings.Join(c.Tags.Names(), ","),
+		"teamids":                  intListToCDString(teamIDs),
+		"type":                     string(c.Type.Name),
+		"userids":                  intListToCDString(c.UserIds),
+	}
+
+	if http := c.Type.HTTP; http != nil {
+		m["encryption"] = strconv.FormatBool(http.Encryption)
+		m["port"] = strconv.Itoa(http.Port)
+		m["postdata"] = http.PostData
+		m["shouldcontain"] = http.ShouldContain
+		m["shouldnotcontain"] = http.ShouldNotContain
+		m["ssl_down_days_before"] = strconv.Itoa(http.SSLDownDaysBefore)
+		m["url"] = http.Url
+		m["verify_certificate"] = strconv.FormatBool(http.VerifyCertificate)
+	}
+
+	if tcp := c.Type.TCP; tcp != nil {
+		m["port"] = strconv.Itoa(tcp.Port)
+		m["stringtoexpect"] = tcp.StringToExpect
+		m["stringtosend"] = tcp.StringToSend
+	}
+
+	if dns := c.Type.DNS; dns != nil {
+		m["expectedip"] = dns.ExpectedIP
+		m["nameserver"] = dns.NameServer
+	}
+
+	return m
+}
+
+// sortedList returns the comma separated list with its items trimmed and
+// sorted.
+func sortedList(list string) string {
+	if list == "" {
+		return ""
+	}
+	items := strings.Split(list, ",")
+	for i := range items {
+		items[i] = strings.TrimSpace(items[i])
+	}
+	sort.Strings(items)
+	return strings.Join(items, ",")
+}
@@ -0,0 +1,99 @@
+package pingdom
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckServiceAssertMatches(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/checks/85975", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{
+			"check": {
+				"id": 85975,
+				"name": "My check",
+				"resolution": 5,
+				"hostname": "example.com",
+				"paused": false,
+				"type": {
+					"http": {
+						"url": "/health",
+						"encryption": true,
+						"port": 443,
+						"shouldcontain": "ok"
+					}
+				},
+				"tags": [{"name": "web", "type": "u", "count": 1}, {"name": "prod", "type": "u", "count": 1}],
+				"userids": [1, 2],
+				"teams": [{"id": 7, "name": "Ops"}]
+			}
+		}`)
+	})
+
+	matching := &HttpCheck{
+		Name:          "My check",
+		Hostname:      "example.com",
+		Resolution:    5,
+		Url:           "/health",
+		Encryption:    true,
+		ShouldContain: "ok",
+		Tags:          "prod,web",
+		UserIds:       []int{2, 1},
+		TeamIds:       []int{7},
+		Username:      "not-returned",
+		Password:      "by-the-api",
+	}
+	assert.NoError(t, client.Checks.AssertMatches(85975, matching))
+
+	drifting := *matching
+	drifting.Resolution = 1
+	drifting.Url = "/status"
+	drifting.Paused = true
+
+	err := client.Checks.AssertMatches(85975, &drifting)
+	var driftErr *CheckDriftError
+	assert.True(t, errors.As(err, &driftErr))
+	assert.Equal(t, 85975, driftErr.CheckID)
+	assert.Equal(t, []CheckDrift{
+		{Param: "paused", Live: "false", Desired: "true"},
+		{Param: "resolution", Live: "5", Desired: "1"},
+		{Param: "url", Live: "/health", Desired: "/status"},
+	}, driftErr.Drifts)
+	assert.EqualError(t, err, `check 85975 drifted: paused is "false", want "true"; resolution is "5", want "1"; url is "/health", want "/status"`)
+}
+
+func TestHashCheck(t *testing.T) {
+	check := &HttpCheck{
+		Name:     "fake check",
+		Hostname: "example.com",
+		Url:      "/health",
+		Tags:     "web,prod",
+		UserIds:  []int{2, 1},
+	}
+	same := &HttpCheck{
+		Name:     "fake check",
+		Hostname: "example.com",
+		Url:      "/health",
+		Tags:     "prod, web",
+		UserIds:  []int{1, 2},
+	}
+	changed := &HttpCheck{
+		Name:     "fake check",
+		Hostname: "example.com",
+		Url:      "/status",
+		Tags:     "web,prod",
+		UserIds:  []int{2, 1},
+	}
+
+	hash := HashCheck(check)
+	assert.Len(t, hash, 64)
+	assert.Equal(t, hash, HashCheck(same))
+	assert.NotEqual(t, hash, HashCheck(changed))
+}
@@ -1,9 +1,58 @@
 package pingdom
 
-import "errors"
+import (
+	"errors"
+	"strings"
+)
 
 // ErrMissingId is an error for when a required Id field is missing.
 var ErrMissingId = errors.New("required field 'Id' missing")
 
 // ErrBadResolution is an error for when an invalid resolution is specified.
 var ErrBadResolution = errors.New("resolution must be either 'hour', 'day' or 'week'")
+
+// FieldError describes a single invalid field of a check.
+type FieldError struct {
+	Field   string
+	Message string
+}
+
+// ValidationError is returned by the Valid methods of checks, and so by the
+// CheckService Create and Update methods before any request is sent.  It
+// lists every failed rule, so they can all be fixed at once.
+type ValidationError struct {
+	Fields []FieldError
+}
+
+func (e *ValidationError) Error() string {
+	messages := make([]string, len(e.Fields))
+	for i, field := range e.Fields {
+		messages[i] = field.Message
+	}
+	return strings.Join(messages, "; ")
+}
+
+// HasField reports whether the named field failed validation.
+func (e *ValidationError) HasField(field string) bool {
+	for _, f := range e.Fields {
+		if f.Field == field {
+			return true
+		}
+	}
+	return false
+}
+
+// add records err, if any, as a failed rule of the given field.
+func (e *ValidationError) add(field string, err error) {
+	if err != nil {
+		e.Fields = append(e.Fields, FieldError{Field: field, Message: err.Error()})
+	}
+}
+
+// err returns e, or nil when no rule failed.
+func (e *ValidationError) err() error {
+	if len(e.Fields) == 0 {
+		return nil
+	}
+	return e
+}
@@ -1,9 +1,17 @@
 package pingdom
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -173,6 +181,82 @@ func TestCheckServiceCreate(t *testing.T) {
 	assert.Equal(t, want, check)
 }
 
+func TestCheckServiceCreateLocation(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/checks", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Location", "/checks/138631")
+		fmt.Fprint(w, `{"check":{"id":138631,"name":"My new HTTP check"}}`)
+	})
+
+	check, err := client.Checks.Create(&HttpCheck{Name: "My new HTTP check", Hostname: "example.com"})
+	assert.NoError(t, err)
+	assert.Equal(t, server.URL+"/checks/138631", check.Location)
+}
+
+func TestCheckServiceCreateByType(t *testing.T) {
+	tests := []struct {
+		name       string
+		check      Check
+		wantParams url.Values
+	}{
+		{
+			name:  "ping",
+			check: &PingCheck{Name: "My ping check", Hostname: "example.com", Resolution: 1},
+			wantParams: url.Values{
+				"type":             {"ping"},
+				"name":             {"My ping check"},
+				"host":             {"example.com"},
+				"resolution":       {"1"},
+				"ipv6":             {"false"},
+				"notifyagainevery": {"0"},
+				"notifywhenbackup": {"false"},
+				"paused":           {"false"},
+			},
+		},
+		{
+			name: "udp",
+			check: &UDPCheck{
+				Name:           "My UDP check",
+				Hostname:       "ntp.example.com",
+				Port:           123,
+				StringToSend:   "ping",
+				StringToExpect: "pong",
+			},
+			wantParams: url.Values{
+				"type":             {"udp"},
+				"name":             {"My UDP check"},
+				"host":             {"ntp.example.com"},
+				"port":             {"123"},
+				"stringtosend":     {"ping"},
+				"stringtoexpect":   {"pong"},
+				"ipv6":             {"false"},
+				"notifyagainevery": {"0"},
+				"notifywhenbackup": {"false"},
+				"paused":           {"false"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setup()
+			defer teardown()
+
+			mux.HandleFunc("/checks", func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, "POST")
+				assert.Equal(t, tt.wantParams, r.URL.Query())
+				fmt.Fprint(w, `{"check":{"id":138631,"name":"My check"}}`)
+			})
+
+			check, err := client.Checks.Create(tt.check)
+			assert.NoError(t, err)
+			assert.Equal(t, &CheckResponse{ID: 138631, Name: "My check"}, check)
+		})
+	}
+}
+
 func TestCheckServiceRead(t *testing.T) {
 	setup()
 	defer teardown()
@@ -458,3 +542,816 @@ func TestCheckServiceResults(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, want, results)
 }
+
+func TestCheckServiceVerifyReachable(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/single", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		assert.Equal(t, url.Values{
+			"host":           {"example.com"},
+			"type":           {"http"},
+			"url":            {"/health"},
+			"encryption":     {"true"},
+			"ipv6":           {"false"},
+			"shouldcontain":  {"ok"},
+			"requestheader0": {"X-Env:prod"},
+		}, r.URL.Query())
+		fmt.Fprint(w, `{
+			"result": {
+				"status": "up",
+				"responsetime": 124,
+				"statusdesc": "OK",
+				"statusdesclong": "OK",
+				"probeid": 33,
+				"probedesc": "Amsterdam 2, Netherlands"
+			}
+		}`)
+	})
+
+	check := HttpCheck{
+		Name:           "My check",
+		Hostname:       "example.com",
+		Url:            "/health",
+		Encryption:     true,
+		Resolution:     5,
+		ShouldContain:  "ok",
+		RequestHeaders: map[string]string{"X-Env": "prod"},
+		UserIds:        []int{1},
+	}
+	want := &SingleCheckResult{
+		Status:         "up",
+		ResponseTime:   124,
+		StatusDesc:     "OK",
+		StatusDescLong: "OK",
+		ProbeID:        33,
+		ProbeDesc:      "Amsterdam 2, Netherlands",
+	}
+
+	up, result, err := client.Checks.VerifyReachable(&check)
+	assert.NoError(t, err)
+	assert.True(t, up)
+	assert.Equal(t, want, result)
+}
+
+func TestCheckServiceVerifyReachableEmptyResponse(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/single", func(w http.ResponseWriter, r *http.Request) {})
+
+	up, result, err := client.Checks.VerifyReachable(&HttpCheck{Name: "n", Hostname: "example.com"})
+	assert.Equal(t, ErrEmptyResponse, err)
+	assert.False(t, up)
+	assert.Nil(t, result)
+}
+
+func TestCheckServiceReadMulti(t *testing.T) {
+	setup()
+	defer teardown()
+
+	for _, id := range []int{1, 2, 3, 4, 5} {
+		id := id
+		mux.HandleFunc(fmt.Sprintf("/checks/%d", id), func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, "GET")
+			fmt.Fprintf(w, `{"check":{"id":%d,"name":"Check %d"}}`, id, id)
+		})
+	}
+	mux.HandleFunc("/checks/6", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"error":{"statuscode":404,"statusdesc":"Not Found","errormessage":"Check not found"}}`)
+	})
+
+	checks, err := client.Checks.ReadMulti([]int{1, 2, 3, 4, 5, 6})
+
+	assert.Len(t, checks, 5)
+	for _, id := range []int{1, 2, 3, 4, 5} {
+		assert.Equal(t, &CheckResponse{ID: id, Name: fmt.Sprintf("Check %d", id), TeamIds: []int{}}, checks[id])
+	}
+
+	var multiErr ReadMultiError
+	assert.True(t, errors.As(err, &multiErr))
+	assert.Len(t, multiErr, 1)
+	assert.True(t, errors.Is(multiErr[6], ErrNotFound))
+	assert.Equal(t, "check 6: 404 Not Found: Check not found", err.Error())
+}
+
+func TestCheckServiceLastError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/results/12345", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		assert.Equal(t, "down", r.URL.Query().Get("status"))
+		assert.Equal(t, "1", r.URL.Query().Get("limit"))
+		fmt.Fprint(w, `{
+			"activeprobes": [259],
+			"results": [
+				{
+					"probeid": 259,
+					"time": 1563370611,
+					"status": "down",
+					"responsetime": 145,
+					"statusdesc": "HTTP Error 500",
+					"statusdesclong": "Internal Server Error"
+				}
+			]
+		}`)
+	})
+
+	result, err := client.Checks.LastError(12345)
+	assert.NoError(t, err)
+	assert.Equal(t, &Result{
+		ProbeID:        259,
+		Time:           1563370611,
+		Status:         "down",
+		ResponseTime:   145,
+		StatusDesc:     "HTTP Error 500",
+		StatusDescLong: "Internal Server Error",
+	}, result)
+	assert.Equal(t, 500, result.HTTPStatusCode())
+}
+
+func TestCheckServiceWatchMany(t *testing.T) {
+	setup()
+	defer teardown()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var mu sync.Mutex
+	calls := 0
+	mux.HandleFunc("/checks", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		calls++
+		status := "up"
+		if calls > 2 {
+			status = "down"
+		}
+		if calls == 6 {
+			cancel()
+		}
+		mu.Unlock()
+		fmt.Fprintf(w, `{
+			"checks": [
+				{"id": 1, "name": "My check 1", "status": "up"},
+				{"id": 2, "name": "My check 2", "status": "%s"},
+				{"id": 3, "name": "Not watched", "status": "%s"}
+			]
+		}`, status, status)
+	})
+
+	var changes []CheckResponse
+	err := client.Checks.WatchMany(ctx, []int{1, 2}, time.Millisecond, func(check CheckResponse) {
+		changes = append(changes, check)
+	})
+
+	assert.Equal(t, context.Canceled, err)
+	assert.Equal(t, []CheckResponse{{ID: 2, Name: "My check 2", Status: "down"}}, changes)
+}
+
+func TestCheckServiceWatchManyCancelSlowPoll(t *testing.T) {
+	setup()
+	defer teardown()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var mu sync.Mutex
+	calls := 0
+	mux.HandleFunc("/checks", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		calls++
+		slow := calls > 1
+		mu.Unlock()
+		if slow {
+			cancel()
+			select {
+			case <-r.Context().Done():
+			case <-time.After(5 * time.Second):
+			}
+			return
+		}
+		fmt.Fprint(w, `{"checks":[{"id":1,"name":"My check 1","status":"up"}]}`)
+	})
+
+	start := time.Now()
+	err := client.Checks.WatchMany(ctx, []int{1}, time.Millisecond, func(CheckResponse) {})
+	assert.Equal(t, context.Canceled, err)
+	assert.True(t, time.Since(start) < 2*time.Second, "the slow poll was not aborted")
+}
+
+func TestCheckServiceWatchManyInvalidInterval(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/checks", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("no poll should be made")
+	})
+
+	for _, interval := range []time.Duration{0, -time.Second} {
+		err := client.Checks.WatchMany(context.Background(), []int{1}, interval, func(CheckResponse) {})
+		assert.EqualError(t, err, "invalid value for `interval`, must be positive")
+	}
+}
+
+func TestCheckServiceCreateWithRegions(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/probes", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "true", r.URL.Query().Get("onlyactive"))
+		fmt.Fprint(w, `{
+			"probes": [
+				{"id": 1, "active": true, "region": "EU"},
+				{"id": 2, "active": true, "region": "EU"},
+				{"id": 3, "active": true, "region": "NA"},
+				{"id": 4, "active": true, "region": "APAC"}
+			]
+		}`)
+	})
+	mux.HandleFunc("/checks", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		assert.Equal(t, "region: EU,region: NA", r.URL.Query().Get("probe_filters"))
+		fmt.Fprint(w, `{"check":{"id":1,"name":"My check"}}`)
+	})
+
+	check := &HttpCheck{Name: "My check", Hostname: "example.com", ProbeFilters: "region: APAC"}
+	created, err := client.Checks.CreateWithRegions(check, []string{"eu", "NA"})
+	assert.NoError(t, err)
+	assert.Equal(t, &CheckResponse{ID: 1, Name: "My check"}, created)
+	assert.Equal(t, "region: APAC", check.ProbeFilters)
+
+	_, err = client.Checks.CreateWithRegions(check, []string{"EU"})
+	assert.EqualError(t, err, "regions [EU] hold 2 active probes, at least 3 are required")
+
+	_, err = client.Checks.CreateWithRegions(check, nil)
+	assert.Error(t, err)
+}
+
+func TestCheckServicePause(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var paused []string
+	mux.HandleFunc("/checks/12345", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		assert.Len(t, r.URL.Query(), 1)
+		paused = append(paused, r.URL.Query().Get("paused"))
+		fmt.Fprint(w, `{"message":"Modification of check was successful!"}`)
+	})
+	want := &PingdomResponse{Message: "Modification of check was successful!"}
+
+	msg, err := client.Checks.Pause(12345)
+	assert.NoError(t, err)
+	assert.Equal(t, want, msg)
+
+	msg, err = client.Checks.Unpause(12345)
+	assert.NoError(t, err)
+	assert.Equal(t, want, msg)
+
+	assert.Equal(t, []string{"true", "false"}, paused)
+}
+
+func TestCheckServicePauseMulti(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/checks", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		assert.Equal(t, url.Values{"checkids": {"1,2,3"}, "paused": {"true"}}, r.URL.Query())
+		fmt.Fprint(w, `{"message":"Modification of 3 checks was successful!"}`)
+	})
+
+	msg, err := client.Checks.PauseMulti([]int{1, 2, 3})
+	assert.NoError(t, err)
+	assert.Equal(t, &PingdomResponse{Message: "Modification of 3 checks was successful!"}, msg)
+
+	_, err = client.Checks.PauseMulti(nil)
+	assert.Error(t, err)
+}
+
+func TestCheckServiceModifyMulti(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/checks", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		assert.Equal(t, url.Values{
+			"checkids":   {"12,34,56"},
+			"paused":     {"false"},
+			"resolution": {"5"},
+		}, r.URL.Query())
+		fmt.Fprint(w, `{"message":"Modification of 3 checks was successful!"}`)
+	})
+
+	msg, err := client.Checks.ModifyMulti([]int{12, 34, 56}, map[string]string{
+		"paused":     "false",
+		"resolution": "5",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, &PingdomResponse{Message: "Modification of 3 checks was successful!"}, msg)
+
+	_, err = client.Checks.ModifyMulti(nil, map[string]string{"paused": "true"})
+	assert.Error(t, err)
+
+	_, err = client.Checks.ModifyMulti([]int{12}, nil)
+	assert.Error(t, err)
+
+	_, err = client.Checks.ModifyMulti([]int{12}, map[string]string{"checkids": "99"})
+	assert.Error(t, err)
+
+	_, err = client.Checks.ModifyMulti([]int{12}, map[string]string{"sendnotificationwhendown": "3"})
+	assert.Error(t, err)
+}
+
+func TestCheckServiceSetTagsMany(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/checks", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		assert.Equal(t, url.Values{"checkids": {"1,2"}, "tags": {"env:prod,team-a"}}, r.URL.Query())
+		fmt.Fprint(w, `{"message":"Modification of 2 checks was successful!"}`)
+	})
+
+	msg, err := client.Checks.SetTagsMany([]int{1, 2}, []string{"Env:Prod", " team-a"})
+	assert.NoError(t, err)
+	assert.Equal(t, &PingdomResponse{Message: "Modification of 2 checks was successful!"}, msg)
+
+	_, err = client.Checks.SetTagsMany(nil, []string{"env:prod"})
+	assert.Error(t, err)
+
+	_, err = client.Checks.SetTagsMany([]int{1}, []string{"bad tag"})
+	assert.Error(t, err)
+}
+
+func TestCheckServiceAddTags(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/checks/12345", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		assert.Equal(t, url.Values{"addtags": {"env:prod,team-a"}}, r.URL.Query())
+		fmt.Fprint(w, `{"message":"Modification of check was successful!"}`)
+	})
+
+	msg, err := client.Checks.AddTags(12345, []string{"Env:Prod", "team-a"})
+	assert.NoError(t, err)
+	assert.Equal(t, &PingdomResponse{Message: "Modification of check was successful!"}, msg)
+
+	_, err = client.Checks.AddTags(12345, nil)
+	assert.Error(t, err)
+}
+
+func TestCheckServiceRemoveTags(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/checks/12345", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "GET" {
+			fmt.Fprint(w, `{"check": {"id": 12345, "name": "tagged", "tags": [
+				{"name": "env:prod", "type": "u", "count": 2},
+				{"name": "team-a", "type": "u", "count": 1},
+				{"name": "web", "type": "u", "count": 5}
+			]}}`)
+			return
+		}
+		testMethod(t, r, "PUT")
+		assert.Equal(t, url.Values{"tags": {"env:prod,web"}}, r.URL.Query())
+		fmt.Fprint(w, `{"message":"Modification of check was successful!"}`)
+	})
+
+	msg, err := client.Checks.RemoveTags(12345, []string{"team-a", "unknown"})
+	assert.NoError(t, err)
+	assert.Equal(t, &PingdomResponse{Message: "Modification of check was successful!"}, msg)
+
+	_, err = client.Checks.RemoveTags(12345, []string{"bad tag"})
+	assert.Error(t, err)
+}
+
+func TestCheckServiceResultsStatusFilter(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/results/12345", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		assert.Equal(t, "down,unconfirmed", r.URL.Query().Get("status"))
+		fmt.Fprint(w, `{"activeprobes": [259], "results": [
+			{"probeid": 259, "time": 1563370611, "status": "down", "responsetime": 0, "statusdesc": "Timeout"}
+		]}`)
+	})
+
+	results, err := client.Checks.Results(12345, map[string]string{"status": "down,unconfirmed"})
+	assert.NoError(t, err)
+	assert.Len(t, results.Results, 1)
+
+	_, err = client.Checks.Results(12345, map[string]string{"status": "failed"})
+	assert.EqualError(t, err, `invalid status "failed" in `+"`status`"+`, must be one of up, down, unconfirmed or unknown`)
+}
+
+func TestCheckServiceListByStatus(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/checks", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"checks": [
+			{"id": 1, "name": "a", "status": "up"},
+			{"id": 2, "name": "b", "status": "down"},
+			{"id": 3, "name": "c", "status": "paused"},
+			{"id": 4, "name": "d", "status": "down"}
+		]}`)
+	})
+
+	checks, err := client.Checks.ListByStatus(context.Background(), "down")
+	assert.NoError(t, err)
+	assert.Equal(t, []CheckResponse{
+		{ID: 2, Name: "b", Status: "down"},
+		{ID: 4, Name: "d", Status: "down"},
+	}, checks)
+
+	checks, err = client.Checks.ListByStatus(context.Background(), "unknown")
+	assert.NoError(t, err)
+	assert.Empty(t, checks)
+
+	_, err = client.Checks.ListByStatus(context.Background(), "failing")
+	assert.Error(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = client.Checks.ListByStatus(ctx, "down")
+	assert.True(t, errors.Is(err, context.Canceled))
+}
+
+func TestCheckServiceMigrateNotifications(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/checks/12345", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "GET" {
+			fmt.Fprint(w, `{"check": {"id": 12345, "name": "legacy", "contactids": [111, 222], "userids": [222, 333]}}`)
+			return
+		}
+		testMethod(t, r, "PUT")
+		assert.Equal(t, url.Values{"contactids": {""}, "userids": {"222,333,111"}}, r.URL.Query())
+		fmt.Fprint(w, `{"message":"Modification of check was successful!"}`)
+	})
+	mux.HandleFunc("/checks/23456", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"check": {"id": 23456, "name": "orphan", "contactids": [111, 999]}}`)
+	})
+	mux.HandleFunc("/checks/34567", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"check": {"id": 34567, "name": "modern", "userids": [333]}}`)
+	})
+	mux.HandleFunc("/alerting/contacts/111", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"contact": {"id": 111, "name": "John Doe", "type": "user"}}`)
+	})
+	mux.HandleFunc("/alerting/contacts/222", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"contact": {"id": 222, "name": "Jane Doe", "type": "user"}}`)
+	})
+	mux.HandleFunc("/alerting/contacts/999", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"error":{"statuscode":404,"statusdesc":"Not Found","errormessage":"Contact not found"}}`)
+	})
+
+	msg, err := client.Checks.MigrateNotifications(12345)
+	assert.NoError(t, err)
+	assert.Equal(t, &PingdomResponse{Message: "Modification of check was successful!"}, msg)
+
+	_, err = client.Checks.MigrateNotifications(23456)
+	assert.True(t, errors.Is(err, ErrNotFound))
+	assert.Contains(t, err.Error(), "has no user equivalent")
+
+	msg, err = client.Checks.MigrateNotifications(34567)
+	assert.NoError(t, err)
+	assert.Nil(t, msg)
+}
+
+func TestCheckServiceMigrateNotificationsContactError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/checks/12345", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"check": {"id": 12345, "name": "legacy", "contactids": [111]}}`)
+	})
+	mux.HandleFunc("/alerting/contacts/111", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		fmt.Fprint(w, `{"error":{"statuscode":401,"statusdesc":"Unauthorized","errormessage":"Invalid token"}}`)
+	})
+
+	_, err := client.Checks.MigrateNotifications(12345)
+	assert.Equal(t, &PingdomError{StatusCode: 401, StatusDesc: "Unauthorized", Message: "Invalid token"}, err)
+}
+
+func TestCheckServiceAge(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.now = func() time.Time { return time.Unix(1563386400, 0) }
+
+	check := &CheckResponse{ID: 12345, Created: 1563386400 - 3*24*3600 - 90}
+	assert.Equal(t, 72*time.Hour+90*time.Second, client.Checks.Age(check))
+	assert.Equal(t, 90*time.Second, check.Age(time.Unix(1563386400-3*24*3600, 0)))
+	assert.Equal(t, time.Duration(0), client.Checks.Age(&CheckResponse{ID: 12345}))
+}
+
+func TestCheckServiceSuppressUntil(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.now = func() time.Time { return time.Unix(1563386400, 0) }
+	until := time.Unix(1563390000, 0)
+
+	mux.HandleFunc("/maintenance", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		assert.Equal(t, url.Values{
+			"description": {"Alerts suppressed for check 12345"},
+			"from":        {"1563386400"},
+			"to":          {"1563390000"},
+			"uptimeids":   {"12345"},
+		}, r.URL.Query())
+		fmt.Fprint(w, `{"maintenance":{"id":789}}`)
+	})
+
+	maintenance, err := client.Checks.SuppressUntil(12345, until)
+	assert.NoError(t, err)
+	assert.Equal(t, &MaintenanceResponse{ID: 789}, maintenance)
+
+	_, err = client.Checks.SuppressUntil(12345, time.Unix(1563386400, 0))
+	assert.Error(t, err)
+}
+
+func TestCheckServiceWriteResultsCSV(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var windows []string
+	mux.HandleFunc("/results/85975", func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		assert.Equal(t, "1000", q.Get("limit"))
+		assert.Equal(t, "up", q.Get("status"))
+		windows = append(windows, q.Get("from")+"-"+q.Get("to"))
+
+		results := []Result{}
+		switch q.Get("offset") {
+		case "0":
+			for i := 0; i < 1000; i++ {
+				results = append(results, Result{ProbeID: 33, Time: 1600003600 - i, Status: "up", ResponseTime: 100 + i})
+			}
+		case "1000":
+			results = append(results,
+				Result{ProbeID: 34, Time: 1600000000, Status: "up", ResponseTime: 95},
+			)
+		default:
+			t.Errorf("unexpected offset %q", q.Get("offset"))
+		}
+		assert.NoError(t, json.NewEncoder(w).Encode(ResultsResponse{Results: results}))
+	})
+
+	var buf bytes.Buffer
+	err := client.Checks.WriteResultsCSV(85975, map[string]string{"status": "up"}, &buf)
+	assert.NoError(t, err)
+
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	assert.Len(t, lines, 1002)
+	assert.Equal(t, "time,probeid,status,responsetime", lines[0])
+	assert.Equal(t, "2020-09-13T13:26:40Z,33,up,100", lines[1])
+	assert.Equal(t, "2020-09-13T12:26:40Z,34,up,95", lines[1001])
+
+	assert.Len(t, windows, 2)
+	assert.Equal(t, windows[0], windows[1])
+}
+
+func TestCheckServiceCreateValidationError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/checks", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request for an invalid check")
+	})
+	mux.HandleFunc("/checks/12345", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request for an invalid check")
+	})
+
+	tests := []struct {
+		name   string
+		check  Check
+		fields []string
+	}{
+		{"http", &HttpCheck{}, []string{"Name", "Hostname"}},
+		{"http invalid", &HttpCheck{Name: "n", Hostname: "h", Resolution: 2}, []string{"Resolution"}},
+		{"ping", &PingCheck{Name: "n"}, []string{"Hostname"}},
+		{"tcp", &TCPCheck{Hostname: "h"}, []string{"Name", "Port"}},
+		{"udp", &UDPCheck{Name: "n", Hostname: "h", Port: 53}, []string{"StringToSend", "StringToExpect"}},
+		{"smtp", &SMTPCheck{}, []string{"Name", "Hostname", "Port"}},
+		{"imap", &IMAPCheck{Name: "n", Hostname: "h", Port: 70000}, []string{"Port"}},
+		{"pop3", &POP3Check{Name: "n", Hostname: "h"}, []string{"Port"}},
+		{"dns", &DNSCheck{Name: "n", Hostname: "h"}, []string{"ExpectedIP", "NameServer"}},
+		{"dns invalid", &DNSCheck{Name: "n", Hostname: "h", ExpectedIP: "nope", NameServer: "ns"}, []string{"ExpectedIP"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := client.Checks.Create(tt.check)
+			var ve *ValidationError
+			if assert.True(t, errors.As(err, &ve)) {
+				fields := make([]string, len(ve.Fields))
+				for i, f := range ve.Fields {
+					fields[i] = f.Field
+				}
+				assert.Equal(t, tt.fields, fields)
+			}
+
+			_, err = client.Checks.Update(12345, tt.check)
+			assert.True(t, errors.As(err, &ve))
+		})
+	}
+}
+
+func TestValidationErrorMessage(t *testing.T) {
+	err := (&HttpCheck{Name: "n"}).Valid()
+	assert.EqualError(t, err, "invalid value for `Hostname`, must contain non-empty string")
+
+	err = (&TCPCheck{}).Valid()
+	assert.EqualError(t, err, "invalid value for `Name`, must contain non-empty string; "+
+		"invalid value for `Hostname`, must contain non-empty string; "+
+		"Invalid value for `Port`.  Must contain an integer >= 1 and <= 65535")
+	assert.True(t, err.(*ValidationError).HasField("Port"))
+	assert.False(t, err.(*ValidationError).HasField("Resolution"))
+
+	err = (&HttpCheck{Hostname: "h", Resolution: 2, ContentType: "text/plain"}).Valid()
+	var ve *ValidationError
+	if assert.True(t, errors.As(err, &ve)) {
+		assert.Equal(t, []FieldError{
+			{Field: "Name", Message: "invalid value for `Name`, must contain non-empty string"},
+			{Field: "Resolution", Message: "invalid value 2 for `Resolution`, allowed values are [1,5,15,30,60]"},
+			{Field: "ContentType", Message: "`ContentType` is only used by POST checks, `PostData` must be declared"},
+		}, ve.Fields)
+	}
+
+	assert.NoError(t, (&HttpCheck{Name: "n", Hostname: "h"}).Valid())
+}
+
+func TestCheckServiceCreateIdempotent(t *testing.T) {
+	setup()
+	defer teardown()
+
+	posts, gets := 0, 0
+	mux.HandleFunc("/checks", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "GET" {
+			gets++
+			fmt.Fprint(w, `{"checks":[{"id":85975,"name":"My check","hostname":"example.com"}]}`)
+			return
+		}
+		testMethod(t, r, "POST")
+		posts++
+		w.WriteHeader(http.StatusServiceUnavailable)
+		fmt.Fprint(w, `{"error":{"statuscode":503,"statusdesc":"Service Unavailable","errormessage":"Try again"}}`)
+	})
+
+	client.retry = RetryPolicy{MinBackoff: time.Millisecond}
+
+	check, err := client.Checks.CreateIdempotent(&HttpCheck{Name: "My check", Hostname: "example.com"})
+	assert.NoError(t, err)
+	assert.Equal(t, &CheckResponse{ID: 85975, Name: "My check", Hostname: "example.com"}, check)
+	assert.Equal(t, 1, posts)
+	assert.Equal(t, 1, gets)
+}
+
+func TestCheckServiceCreateIdempotentRetries(t *testing.T) {
+	setup()
+	defer teardown()
+
+	posts := 0
+	mux.HandleFunc("/checks", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "GET" {
+			fmt.Fprint(w, `{"checks":[{"id":1,"name":"Other check","hostname":"example.com"}]}`)
+			return
+		}
+		posts++
+		if posts == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			fmt.Fprint(w, `<html>Bad Gateway</html>`)
+			return
+		}
+		fmt.Fprint(w, `{"check":{"id":85975,"name":"My check"}}`)
+	})
+
+	client.retry = RetryPolicy{MaxRetries: 2, MinBackoff: time.Millisecond}
+
+	check, err := client.Checks.CreateIdempotent(&HttpCheck{Name: "My check", Hostname: "example.com"})
+	assert.NoError(t, err)
+	assert.Equal(t, 85975, check.ID)
+	assert.Equal(t, 2, posts)
+}
+
+func TestCheckServiceCreateIdempotentDuplicate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	posts := 0
+	mux.HandleFunc("/checks", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "GET" {
+			fmt.Fprint(w, `{"checks":[{"id":85975,"name":"My check","hostname":"example.com"}]}`)
+			return
+		}
+		posts++
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"error":{"statuscode":400,"statusdesc":"Bad Request","errormessage":"A check with this name already exists"}}`)
+	})
+
+	check, err := client.Checks.CreateIdempotent(&HttpCheck{Name: "My check", Hostname: "example.com"})
+	assert.NoError(t, err)
+	assert.Equal(t, 85975, check.ID)
+	assert.Equal(t, 1, posts)
+
+	posts = 0
+	_, err = client.Checks.CreateIdempotent(&HttpCheck{Name: "My check", Hostname: "other.com"})
+	assert.True(t, errors.Is(err, ErrDuplicateName))
+	assert.Equal(t, 1, posts)
+}
+
+func TestCheckServiceResultsByCountry(t *testing.T) {
+	setup()
+	defer teardown()
+
+	probeCalls := 0
+	mux.HandleFunc("/probes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		probeCalls++
+		assert.Equal(t, "true", r.URL.Query().Get("onlyactive"))
+		fmt.Fprint(w, `{"probes":[
+			{"id":1,"country":"Germany","countryiso":"DE","active":true},
+			{"id":2,"country":"Sweden","countryiso":"SE","active":true},
+			{"id":3,"country":"Germany","countryiso":"DE","active":true}
+		]}`)
+	})
+	var query url.Values
+	mux.HandleFunc("/results/12345", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		query = r.URL.Query()
+		fmt.Fprint(w, `{"activeprobes":[1,3],"results":[{"probeid":3,"time":1563370611,"status":"down"}]}`)
+	})
+
+	results, err := client.Checks.ResultsByCountry(12345, "de", map[string]string{"status": "down", "probes": "2"})
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 3}, results.ActiveProbes)
+	assert.Equal(t, "1,3", query.Get("probes"))
+	assert.Equal(t, "down", query.Get("status"))
+	assert.Equal(t, 1, probeCalls)
+
+	_, err = client.Checks.ResultsByCountry(12345, "Germany")
+	assert.NoError(t, err)
+	assert.Equal(t, "1,3", query.Get("probes"))
+
+	_, err = client.Checks.ResultsByCountry(12345, "France")
+	assert.EqualError(t, err, `no active probes in country "France"`)
+
+	_, err = client.Checks.ResultsByCountry(12345, " ")
+	assert.Error(t, err)
+}
+
+func TestCheckServiceListWithQuery(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/checks", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		assert.Equal(t, url.Values{"tags": {"prod"}, "include_tags": {"true"}}, r.URL.Query())
+		fmt.Fprint(w, `{"checks":[{"id":1,"name":"web","tags":[{"name":"prod","type":"u","count":2},{"name":"web","type":"u","count":1}]}]}`)
+	})
+
+	checks, err := client.Checks.ListWithQuery(CheckListQuery{Tags: []string{"prod"}, IncludeTags: true})
+	assert.NoError(t, err)
+	assert.Len(t, checks, 1)
+	assert.Equal(t, []string{"prod", "web"}, checks[0].Tags.Names())
+
+	_, err = client.Checks.ListWithQuery(CheckListQuery{Limit: -1})
+	assert.Error(t, err)
+}
+
+func TestCheckServiceListModifiedSince(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/checks", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"checks":[
+			{"id":1,"name":"a","lastmodified":1563300000},
+			{"id":2,"name":"b","lastmodified":1563386400},
+			{"id":3,"name":"c","lastmodified":1563386401},
+			{"id":4,"name":"d"}
+		]}`)
+	})
+
+	checks, err := client.Checks.ListModifiedSince(time.Unix(1563386400, 500000000))
+	assert.NoError(t, err)
+	assert.Equal(t, []CheckResponse{
+		{ID: 2, Name: "b", LastModified: 1563386400},
+		{ID: 3, Name: "c", LastModified: 1563386401},
+		{ID: 4, Name: "d"},
+	}, checks)
+}
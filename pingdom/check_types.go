@@ -2,12 +2,33 @@ package pingdom
 
 import (
 	"fmt"
+	"net"
 	"sort"
 	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// CheckType is the type of a check, as named by the Pingdom API.
+type CheckType string
+
+// The check types supported by Pingdom.
+const (
+	CheckTypeHTTP       CheckType = "http"
+	CheckTypeHTTPCustom CheckType = "httpcustom"
+	CheckTypeTCP        CheckType = "tcp"
+	CheckTypeUDP        CheckType = "udp"
+	CheckTypePing       CheckType = "ping"
+	CheckTypeDNS        CheckType = "dns"
+	CheckTypeSMTP       CheckType = "smtp"
+	CheckTypeIMAP       CheckType = "imap"
+	CheckTypePOP3       CheckType = "pop3"
 )
 
 // HttpCheck represents a Pingdom HTTP check.
 type HttpCheck struct {
+	// ContentType is sent as the Content-Type header of POST checks.
+	ContentType              string            `json:"-"`
 	CustomMessage            string            `json:"custom_message,omitempty"`
 	Encryption               bool              `json:"encryption,omitempty"`
 	Hostname                 string            `json:"hostname,omitempty"`
@@ -39,6 +60,7 @@ type HttpCheck struct {
 // PingCheck represents a Pingdom ping check.
 type PingCheck struct {
 	Hostname                 string `json:"hostname,omitempty"`
+	IPV6                     bool   `json:"ipv6,omitempty"`
 	IntegrationIds           []int  `json:"integrationids,omitempty"`
 	Name                     string `json:"name"`
 	NotifyAgainEvery         int    `json:"notifyagainevery,omitempty"`
@@ -75,6 +97,28 @@ type TCPCheck struct {
 	UserIds                  []int  `json:"userids,omitempty"`
 }
 
+// UDPCheck represents a Pingdom UDP check.
+type UDPCheck struct {
+	CustomMessage            string `json:"custom_message,omitempty"`
+	Hostname                 string `json:"hostname,omitempty"`
+	IPV6                     bool   `json:"ipv6,omitempty"`
+	IntegrationIds           []int  `json:"integrationids,omitempty"`
+	Name                     string `json:"name"`
+	NotifyAgainEvery         int    `json:"notifyagainevery,omitempty"`
+	NotifyWhenBackup         bool   `json:"notifywhenbackup,omitempty"`
+	Paused                   bool   `json:"paused,omitempty"`
+	Port                     int    `json:"port"`
+	ProbeFilters             string `json:"probe_filters,omitempty"`
+	Resolution               int    `json:"resolution,omitempty"`
+	ResponseTimeThreshold    int    `json:"responsetime_threshold,omitempty"`
+	SendNotificationWhenDown int    `json:"sendnotificationwhendown,omitempty"`
+	StringToExpect           string `json:"stringtoexpect,omitempty"`
+	StringToSend             string `json:"stringtosend,omitempty"`
+	Tags                     string `json:"tags,omitempty"`
+	TeamIds                  []int  `json:"teamids,omitempty"`
+	UserIds                  []int  `json:"userids,omitempty"`
+}
+
 // DNSCheck represents a Pingdom DNS check.
 type DNSCheck struct {
 	ExpectedIP               string `json:"expectedip,omitempty"`
@@ -88,7 +132,76 @@ type DNSCheck struct {
 	Paused                   bool   `json:"paused,omitempty"`
 	ProbeFilters             string `json:"probe_filters,omitempty"`
 	Resolution               int    `json:"resolution,omitempty"`
+	ResponseTimeThreshold    int    `json:"responsetime_threshold,omitempty"`
+	SendNotificationWhenDown int    `json:"sendnotificationwhendown,omitempty"`
+	Tags                     string `json:"tags,omitempty"`
+	TeamIds                  []int  `json:"teamids,omitempty"`
+	UserIds                  []int  `json:"userids,omitempty"`
+}
+
+// SMTPCheck represents a Pingdom SMTP check.
+type SMTPCheck struct {
+	CustomMessage            string `json:"custom_message,omitempty"`
+	Encryption               bool   `json:"encryption,omitempty"`
+	Hostname                 string `json:"hostname,omitempty"`
+	IPV6                     bool   `json:"ipv6,omitempty"`
+	IntegrationIds           []int  `json:"integrationids,omitempty"`
+	Name                     string `json:"name"`
+	NotifyAgainEvery         int    `json:"notifyagainevery,omitempty"`
+	NotifyWhenBackup         bool   `json:"notifywhenbackup,omitempty"`
+	Password                 string `json:"password,omitempty"`
+	Paused                   bool   `json:"paused,omitempty"`
+	Port                     int    `json:"port"`
+	ProbeFilters             string `json:"probe_filters,omitempty"`
+	Resolution               int    `json:"resolution,omitempty"`
+	ResponseTimeThreshold    int    `json:"responsetime_threshold,omitempty"`
+	SendNotificationWhenDown int    `json:"sendnotificationwhendown,omitempty"`
+	StringToExpect           string `json:"stringtoexpect,omitempty"`
+	Tags                     string `json:"tags,omitempty"`
+	TeamIds                  []int  `json:"teamids,omitempty"`
+	UserIds                  []int  `json:"userids,omitempty"`
+	Username                 string `json:"username,omitempty"`
+}
+
+// IMAPCheck represents a Pingdom IMAP check.
+type IMAPCheck struct {
+	CustomMessage            string `json:"custom_message,omitempty"`
+	Encryption               bool   `json:"encryption,omitempty"`
+	Hostname                 string `json:"hostname,omitempty"`
+	IPV6                     bool   `json:"ipv6,omitempty"`
+	IntegrationIds           []int  `json:"integrationids,omitempty"`
+	Name                     string `json:"name"`
+	NotifyAgainEvery         int    `json:"notifyagainevery,omitempty"`
+	NotifyWhenBackup         bool   `json:"notifywhenbackup,omitempty"`
+	Paused                   bool   `json:"paused,omitempty"`
+	Port                     int    `json:"port"`
+	ProbeFilters             string `json:"probe_filters,omitempty"`
+	Resolution               int    `json:"resolution,omitempty"`
+	ResponseTimeThreshold    int    `json:"responsetime_threshold,omitempty"`
 	SendNotificationWhenDown int    `json:"sendnotificationwhendown,omitempty"`
+	StringToExpect           string `json:"stringtoexpect,omitempty"`
+	Tags                     string `json:"tags,omitempty"`
+	TeamIds                  []int  `json:"teamids,omitempty"`
+	UserIds                  []int  `json:"userids,omitempty"`
+}
+
+// POP3Check represents a Pingdom POP3 check.
+type POP3Check struct {
+	CustomMessage            string `json:"custom_message,omitempty"`
+	Encryption               bool   `json:"encryption,omitempty"`
+	Hostname                 string `json:"hostname,omitempty"`
+	IPV6                     bool   `json:"ipv6,omitempty"`
+	IntegrationIds           []int  `json:"integrationids,omitempty"`
+	Name                     string `json:"name"`
+	NotifyAgainEvery         int    `json:"notifyagainevery,omitempty"`
+	NotifyWhenBackup         bool   `json:"notifywhenbackup,omitempty"`
+	Paused                   bool   `json:"paused,omitempty"`
+	Port                     int    `json:"port"`
+	ProbeFilters             string `json:"probe_filters,omitempty"`
+	Resolution               int    `json:"resolution,omitempty"`
+	ResponseTimeThreshold    int    `json:"responsetime_threshold,omitempty"`
+	SendNotificationWhenDown int    `json:"sendnotificationwhendown,omitempty"`
+	StringToExpect           string `json:"stringtoexpect,omitempty"`
 	Tags                     string `json:"tags,omitempty"`
 	TeamIds                  []int  `json:"teamids,omitempty"`
 	UserIds                  []int  `json:"userids,omitempty"`
@@ -105,6 +218,13 @@ type SummaryPerformanceRequest struct {
 	To            int
 }
 
+// NotifyAgainAfterFailures returns the NotifyAgainEvery value repeating
+// alerts after every n consecutive failed check results.  NotifyAgainEvery
+// counts failed results, not minutes, and zero means alerts are not repeated.
+func NotifyAgainAfterFailures(n int) int {
+	return n
+}
+
 // PutParams returns a map of parameters for an HttpCheck that can be sent along
 // with an HTTP PUT request.
 func (ck *HttpCheck) PutParams() map[string]string {
@@ -120,7 +240,7 @@ func (ck *HttpCheck) PutParams() map[string]string {
 		"paused":           strconv.FormatBool(ck.Paused),
 		"postdata":         ck.PostData,
 		"probe_filters":    ck.ProbeFilters,
-		"tags":             ck.Tags,
+		"tags":             normalizeTags(ck.Tags),
 		"teamids":          intListToCDString(ck.TeamIds),
 		"url":              ck.Url,
 		"userids":          intListToCDString(ck.UserIds),
@@ -139,10 +259,6 @@ func (ck *HttpCheck) PutParams() map[string]string {
 		m["port"] = strconv.Itoa(ck.Port)
 	}
 
-	if ck.SendNotificationWhenDown != 0 {
-		m["sendnotificationwhendown"] = strconv.Itoa(ck.SendNotificationWhenDown)
-	}
-
 	if ck.ResponseTimeThreshold != 0 {
 		m["responsetime_threshold"] = strconv.Itoa(ck.ResponseTimeThreshold)
 	}
@@ -169,18 +285,32 @@ func (ck *HttpCheck) PutParams() map[string]string {
 	}
 
 	// Convert headers
+	requestHeaders := ck.requestHeaders()
 	var headers []string
-	for k := range ck.RequestHeaders {
+	for k := range requestHeaders {
 		headers = append(headers, k)
 	}
 	sort.Strings(headers)
 	for i, k := range headers {
-		m[fmt.Sprintf("requestheader%d", i)] = fmt.Sprintf("%s:%s", k, ck.RequestHeaders[k])
+		m[fmt.Sprintf("requestheader%d", i)] = fmt.Sprintf("%s:%s", k, requestHeaders[k])
 	}
 
 	return m
 }
 
+// requestHeaders returns the RequestHeaders of the check, along with the
+// Content-Type header when ContentType is set.
+func (ck *HttpCheck) requestHeaders() map[string]string {
+	if ck.ContentType == "" {
+		return ck.RequestHeaders
+	}
+	headers := map[string]string{"Content-Type": ck.ContentType}
+	for k, v := range ck.RequestHeaders {
+		headers[k] = v
+	}
+	return headers
+}
+
 // PostParams returns a map of parameters for an HttpCheck that can be sent along
 // with an HTTP POST request. They are the same than the Put params, but
 // empty strings cleared out, to avoid Pingdom API reject the request.
@@ -192,7 +322,7 @@ func (ck *HttpCheck) PostParams() map[string]string {
 			delete(params, k)
 		}
 	}
-	params["type"] = "http"
+	params["type"] = string(CheckTypeHTTP)
 
 	return params
 }
@@ -200,15 +330,43 @@ func (ck *HttpCheck) PostParams() map[string]string {
 // Valid determines whether the HttpCheck contains valid fields.  This can be
 // used to guard against sending illegal values to the Pingdom API.
 func (ck *HttpCheck) Valid() error {
-	if err := validCommonParameters(ck.Name, ck.Hostname, ck.Resolution); err != nil {
-		return err
-	}
+	v := &ValidationError{}
+	validCommonParameters(v, ck.Name, ck.Hostname, ck.Resolution)
+	validNotificationSettings(v, ck.SendNotificationWhenDown, ck.NotifyAgainEvery)
+	v.add("Tags", validTags(ck.Tags))
+	v.add("CustomMessage", validCustomMessage(ck.CustomMessage))
 
 	if ck.ShouldContain != "" && ck.ShouldNotContain != "" {
-		return fmt.Errorf("`ShouldContain` and `ShouldNotContain` must not be declared at the same time")
+		v.add("ShouldNotContain", fmt.Errorf("`ShouldContain` and `ShouldNotContain` must not be declared at the same time"))
 	}
 
-	return nil
+	if ck.SSLDownDaysBefore != nil && *ck.SSLDownDaysBefore < 0 {
+		v.add("SSLDownDaysBefore", fmt.Errorf("invalid value %d for `SSLDownDaysBefore`, must not be negative", *ck.SSLDownDaysBefore))
+	}
+
+	// Pingdom sends a POST request exactly when there is post data.
+	if ck.ContentType != "" && ck.PostData == "" {
+		v.add("ContentType", fmt.Errorf("`ContentType` is only used by POST checks, `PostData` must be declared"))
+	}
+
+	names := make([]string, 0, len(ck.RequestHeaders))
+	for name := range ck.RequestHeaders {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if ck.ContentType != "" && strings.EqualFold(name, "Content-Type") {
+			v.add("RequestHeaders", fmt.Errorf("`ContentType` and the %q request header must not be declared at the same time", name))
+		}
+
+		// Headers are sent as "Name:Value", so a name must not contain a colon.
+		if name == "" || strings.Contains(name, ":") {
+			v.add("RequestHeaders", fmt.Errorf("invalid request header name %q, must be non-empty and contain no colon", name))
+		}
+	}
+
+	return v.err()
 }
 
 // PutParams returns a map of parameters for a PingCheck that can be sent along
@@ -217,11 +375,13 @@ func (ck *PingCheck) PutParams() map[string]string {
 	m := map[string]string{
 		"host":             ck.Hostname,
 		"integrationids":   intListToCDString(ck.IntegrationIds),
+		"ipv6":             strconv.FormatBool(ck.IPV6),
 		"name":             ck.Name,
 		"notifyagainevery": strconv.Itoa(ck.NotifyAgainEvery),
 		"notifywhenbackup": strconv.FormatBool(ck.NotifyWhenBackup),
 		"paused":           strconv.FormatBool(ck.Paused),
 		"probe_filters":    ck.ProbeFilters,
+		"tags":             normalizeTags(ck.Tags),
 		"teamids":          intListToCDString(ck.TeamIds),
 		"userids":          intListToCDString(ck.UserIds),
 	}
@@ -252,18 +412,19 @@ func (ck *PingCheck) PostParams() map[string]string {
 		}
 	}
 
-	params["type"] = "ping"
+	params["type"] = string(CheckTypePing)
 	return params
 }
 
 // Valid determines whether the PingCheck contains valid fields.  This can be
 // used to guard against sending illegal values to the Pingdom API.
 func (ck *PingCheck) Valid() error {
-	if err := validCommonParameters(ck.Name, ck.Hostname, ck.Resolution); err != nil {
-		return err
-	}
+	v := &ValidationError{}
+	validCommonParameters(v, ck.Name, ck.Hostname, ck.Resolution)
+	validNotificationSettings(v, ck.SendNotificationWhenDown, ck.NotifyAgainEvery)
+	v.add("Tags", validTags(ck.Tags))
 
-	return nil
+	return v.err()
 }
 
 // PutParams returns a map of parameters for a TCPCheck that can be sent along
@@ -280,7 +441,7 @@ func (ck *TCPCheck) PutParams() map[string]string {
 		"paused":           strconv.FormatBool(ck.Paused),
 		"port":             strconv.Itoa(ck.Port),
 		"probe_filters":    ck.ProbeFilters,
-		"tags":             ck.Tags,
+		"tags":             normalizeTags(ck.Tags),
 		"teamids":          intListToCDString(ck.TeamIds),
 		"userids":          intListToCDString(ck.UserIds),
 	}
@@ -319,22 +480,300 @@ func (ck *TCPCheck) PostParams() map[string]string {
 		}
 	}
 
-	params["type"] = "tcp"
+	params["type"] = string(CheckTypeTCP)
 	return params
 }
 
 // Valid determines whether the TCPCheck contains valid fields.  This can be
 // used to guard against sending illegal values to the Pingdom API.
 func (ck *TCPCheck) Valid() error {
-	if err := validCommonParameters(ck.Name, ck.Hostname, ck.Resolution); err != nil {
-		return err
+	v := &ValidationError{}
+	validCommonParameters(v, ck.Name, ck.Hostname, ck.Resolution)
+	validNotificationSettings(v, ck.SendNotificationWhenDown, ck.NotifyAgainEvery)
+	v.add("Tags", validTags(ck.Tags))
+	v.add("CustomMessage", validCustomMessage(ck.CustomMessage))
+	validPort(v, ck.Port)
+
+	return v.err()
+}
+
+// PutParams returns a map of parameters for a UDPCheck that can be sent along
+// with an HTTP PUT request.
+func (ck *UDPCheck) PutParams() map[string]string {
+	m := map[string]string{
+		"custom_message":   ck.CustomMessage,
+		"host":             ck.Hostname,
+		"integrationids":   intListToCDString(ck.IntegrationIds),
+		"ipv6":             strconv.FormatBool(ck.IPV6),
+		"name":             ck.Name,
+		"notifyagainevery": strconv.Itoa(ck.NotifyAgainEvery),
+		"notifywhenbackup": strconv.FormatBool(ck.NotifyWhenBackup),
+		"paused":           strconv.FormatBool(ck.Paused),
+		"port":             strconv.Itoa(ck.Port),
+		"probe_filters":    ck.ProbeFilters,
+		"tags":             normalizeTags(ck.Tags),
+		"teamids":          intListToCDString(ck.TeamIds),
+		"userids":          intListToCDString(ck.UserIds),
+	}
+
+	if ck.Resolution != 0 {
+		m["resolution"] = strconv.Itoa(ck.Resolution)
 	}
 
-	if ck.Port < 1 || ck.Port > 65535 {
-		return fmt.Errorf("Invalid value for `Port`.  Must contain an integer >= 1 and <= 65535")
+	if ck.ResponseTimeThreshold != 0 {
+		m["responsetime_threshold"] = strconv.Itoa(ck.ResponseTimeThreshold)
 	}
 
-	return nil
+	if ck.SendNotificationWhenDown != 0 {
+		m["sendnotificationwhendown"] = strconv.Itoa(ck.SendNotificationWhenDown)
+	}
+
+	if ck.StringToSend != "" {
+		m["stringtosend"] = ck.StringToSend
+	}
+
+	if ck.StringToExpect != "" {
+		m["stringtoexpect"] = ck.StringToExpect
+	}
+
+	return m
+}
+
+// PostParams returns a map of parameters for a UDPCheck that can be sent along
+// with an HTTP POST request. Same as PUT.
+func (ck *UDPCheck) PostParams() map[string]string {
+	params := ck.PutParams()
+
+	for k, v := range params {
+		if v == "" {
+			delete(params, k)
+		}
+	}
+
+	params["type"] = string(CheckTypeUDP)
+	return params
+}
+
+// Valid determines whether the UDPCheck contains valid fields.  This can be
+// used to guard against sending illegal values to the Pingdom API.
+func (ck *UDPCheck) Valid() error {
+	v := &ValidationError{}
+	validCommonParameters(v, ck.Name, ck.Hostname, ck.Resolution)
+	validNotificationSettings(v, ck.SendNotificationWhenDown, ck.NotifyAgainEvery)
+	v.add("Tags", validTags(ck.Tags))
+	v.add("CustomMessage", validCustomMessage(ck.CustomMessage))
+	validPort(v, ck.Port)
+
+	if ck.StringToSend == "" {
+		v.add("StringToSend", fmt.Errorf("invalid value for `StringToSend`, UDP checks must declare both `StringToSend` and `StringToExpect`"))
+	}
+
+	if ck.StringToExpect == "" {
+		v.add("StringToExpect", fmt.Errorf("invalid value for `StringToExpect`, UDP checks must declare both `StringToSend` and `StringToExpect`"))
+	}
+
+	return v.err()
+}
+
+// PutParams returns a map of parameters for an SMTPCheck that can be sent along
+// with an HTTP PUT request.
+func (ck *SMTPCheck) PutParams() map[string]string {
+	m := map[string]string{
+		"custom_message":   ck.CustomMessage,
+		"encryption":       strconv.FormatBool(ck.Encryption),
+		"host":             ck.Hostname,
+		"integrationids":   intListToCDString(ck.IntegrationIds),
+		"ipv6":             strconv.FormatBool(ck.IPV6),
+		"name":             ck.Name,
+		"notifyagainevery": strconv.Itoa(ck.NotifyAgainEvery),
+		"notifywhenbackup": strconv.FormatBool(ck.NotifyWhenBackup),
+		"paused":           strconv.FormatBool(ck.Paused),
+		"port":             strconv.Itoa(ck.Port),
+		"probe_filters":    ck.ProbeFilters,
+		"stringtoexpect":   ck.StringToExpect,
+		"tags":             normalizeTags(ck.Tags),
+		"teamids":          intListToCDString(ck.TeamIds),
+		"userids":          intListToCDString(ck.UserIds),
+	}
+
+	if ck.Resolution != 0 {
+		m["resolution"] = strconv.Itoa(ck.Resolution)
+	}
+
+	if ck.ResponseTimeThreshold != 0 {
+		m["responsetime_threshold"] = strconv.Itoa(ck.ResponseTimeThreshold)
+	}
+
+	if ck.SendNotificationWhenDown != 0 {
+		m["sendnotificationwhendown"] = strconv.Itoa(ck.SendNotificationWhenDown)
+	}
+
+	// Convert auth
+	if ck.Username != "" {
+		m["auth"] = fmt.Sprintf("%s:%s", ck.Username, ck.Password)
+	}
+
+	return m
+}
+
+// PostParams returns a map of parameters for an SMTPCheck that can be sent along
+// with an HTTP POST request. Same as PUT.
+func (ck *SMTPCheck) PostParams() map[string]string {
+	params := ck.PutParams()
+
+	for k, v := range params {
+		if v == "" {
+			delete(params, k)
+		}
+	}
+
+	params["type"] = string(CheckTypeSMTP)
+	return params
+}
+
+// Valid determines whether the SMTPCheck contains valid fields.  This can be
+// used to guard against sending illegal values to the Pingdom API.
+func (ck *SMTPCheck) Valid() error {
+	v := &ValidationError{}
+	validCommonParameters(v, ck.Name, ck.Hostname, ck.Resolution)
+	validNotificationSettings(v, ck.SendNotificationWhenDown, ck.NotifyAgainEvery)
+	v.add("Tags", validTags(ck.Tags))
+	v.add("CustomMessage", validCustomMessage(ck.CustomMessage))
+	validPort(v, ck.Port)
+
+	if ck.Password != "" && ck.Username == "" {
+		v.add("Username", fmt.Errorf("`Password` requires `Username` to be declared"))
+	}
+
+	return v.err()
+}
+
+// PutParams returns a map of parameters for an IMAPCheck that can be sent along
+// with an HTTP PUT request.
+func (ck *IMAPCheck) PutParams() map[string]string {
+	m := map[string]string{
+		"custom_message":   ck.CustomMessage,
+		"encryption":       strconv.FormatBool(ck.Encryption),
+		"host":             ck.Hostname,
+		"integrationids":   intListToCDString(ck.IntegrationIds),
+		"ipv6":             strconv.FormatBool(ck.IPV6),
+		"name":             ck.Name,
+		"notifyagainevery": strconv.Itoa(ck.NotifyAgainEvery),
+		"notifywhenbackup": strconv.FormatBool(ck.NotifyWhenBackup),
+		"paused":           strconv.FormatBool(ck.Paused),
+		"port":             strconv.Itoa(ck.Port),
+		"probe_filters":    ck.ProbeFilters,
+		"stringtoexpect":   ck.StringToExpect,
+		"tags":             normalizeTags(ck.Tags),
+		"teamids":          intListToCDString(ck.TeamIds),
+		"userids":          intListToCDString(ck.UserIds),
+	}
+
+	if ck.Resolution != 0 {
+		m["resolution"] = strconv.Itoa(ck.Resolution)
+	}
+
+	if ck.ResponseTimeThreshold != 0 {
+		m["responsetime_threshold"] = strconv.Itoa(ck.ResponseTimeThreshold)
+	}
+
+	if ck.SendNotificationWhenDown != 0 {
+		m["sendnotificationwhendown"] = strconv.Itoa(ck.SendNotificationWhenDown)
+	}
+
+	return m
+}
+
+// PostParams returns a map of parameters for an IMAPCheck that can be sent along
+// with an HTTP POST request. Same as PUT.
+func (ck *IMAPCheck) PostParams() map[string]string {
+	params := ck.PutParams()
+
+	for k, v := range params {
+		if v == "" {
+			delete(params, k)
+		}
+	}
+
+	params["type"] = string(CheckTypeIMAP)
+	return params
+}
+
+// Valid determines whether the IMAPCheck contains valid fields.  This can be
+// used to guard against sending illegal values to the Pingdom API.
+func (ck *IMAPCheck) Valid() error {
+	v := &ValidationError{}
+	validCommonParameters(v, ck.Name, ck.Hostname, ck.Resolution)
+	validNotificationSettings(v, ck.SendNotificationWhenDown, ck.NotifyAgainEvery)
+	v.add("Tags", validTags(ck.Tags))
+	v.add("CustomMessage", validCustomMessage(ck.CustomMessage))
+	validPort(v, ck.Port)
+
+	return v.err()
+}
+
+// PutParams returns a map of parameters for a POP3Check that can be sent along
+// with an HTTP PUT request.
+func (ck *POP3Check) PutParams() map[string]string {
+	m := map[string]string{
+		"custom_message":   ck.CustomMessage,
+		"encryption":       strconv.FormatBool(ck.Encryption),
+		"host":             ck.Hostname,
+		"integrationids":   intListToCDString(ck.IntegrationIds),
+		"ipv6":             strconv.FormatBool(ck.IPV6),
+		"name":             ck.Name,
+		"notifyagainevery": strconv.Itoa(ck.NotifyAgainEvery),
+		"notifywhenbackup": strconv.FormatBool(ck.NotifyWhenBackup),
+		"paused":           strconv.FormatBool(ck.Paused),
+		"port":             strconv.Itoa(ck.Port),
+		"probe_filters":    ck.ProbeFilters,
+		"stringtoexpect":   ck.StringToExpect,
+		"tags":             normalizeTags(ck.Tags),
+		"teamids":          intListToCDString(ck.TeamIds),
+		"userids":          intListToCDString(ck.UserIds),
+	}
+
+	if ck.Resolution != 0 {
+		m["resolution"] = strconv.Itoa(ck.Resolution)
+	}
+
+	if ck.ResponseTimeThreshold != 0 {
+		m["responsetime_threshold"] = strconv.Itoa(ck.ResponseTimeThreshold)
+	}
+
+	if ck.SendNotificationWhenDown != 0 {
+		m["sendnotificationwhendown"] = strconv.Itoa(ck.SendNotificationWhenDown)
+	}
+
+	return m
+}
+
+// PostParams returns a map of parameters for a POP3Check that can be sent along
+// with an HTTP POST request. Same as PUT.
+func (ck *POP3Check) PostParams() map[string]string {
+	params := ck.PutParams()
+
+	for k, v := range params {
+		if v == "" {
+			delete(params, k)
+		}
+	}
+
+	params["type"] = string(CheckTypePOP3)
+	return params
+}
+
+// Valid determines whether the POP3Check contains valid fields.  This can be
+// used to guard against sending illegal values to the Pingdom API.
+func (ck *POP3Check) Valid() error {
+	v := &ValidationError{}
+	validCommonParameters(v, ck.Name, ck.Hostname, ck.Resolution)
+	validNotificationSettings(v, ck.SendNotificationWhenDown, ck.NotifyAgainEvery)
+	v.add("Tags", validTags(ck.Tags))
+	v.add("CustomMessage", validCustomMessage(ck.CustomMessage))
+	validPort(v, ck.Port)
+
+	return v.err()
 }
 
 // PutParams returns a map of parameters for a DNSCheck that can be sent along
@@ -351,7 +790,7 @@ func (ck *DNSCheck) PutParams() map[string]string {
 		"notifywhenbackup": strconv.FormatBool(ck.NotifyWhenBackup),
 		"paused":           strconv.FormatBool(ck.Paused),
 		"probe_filters":    ck.ProbeFilters,
-		"tags":             ck.Tags,
+		"tags":             normalizeTags(ck.Tags),
 		"teamids":          intListToCDString(ck.TeamIds),
 		"userids":          intListToCDString(ck.UserIds),
 	}
@@ -364,6 +803,10 @@ func (ck *DNSCheck) PutParams() map[string]string {
 		m["sendnotificationwhendown"] = strconv.Itoa(ck.SendNotificationWhenDown)
 	}
 
+	if ck.ResponseTimeThreshold != 0 {
+		m["responsetime_threshold"] = strconv.Itoa(ck.ResponseTimeThreshold)
+	}
+
 	return m
 }
 
@@ -378,26 +821,29 @@ func (ck *DNSCheck) PostParams() map[string]string {
 		}
 	}
 
-	params["type"] = "dns"
+	params["type"] = string(CheckTypeDNS)
 	return params
 }
 
 // Valid determines whether the DNSCheck contains valid fields.  This can be
 // used to guard against sending illegal values to the Pingdom API.
 func (ck *DNSCheck) Valid() error {
-	if err := validCommonParameters(ck.Name, ck.Hostname, ck.Resolution); err != nil {
-		return err
-	}
+	v := &ValidationError{}
+	validCommonParameters(v, ck.Name, ck.Hostname, ck.Resolution)
+	validNotificationSettings(v, ck.SendNotificationWhenDown, ck.NotifyAgainEvery)
+	v.add("Tags", validTags(ck.Tags))
 
 	if ck.ExpectedIP == "" {
-		return fmt.Errorf("invalid value for `ExpectedIP`, must contain non-empty string")
+		v.add("ExpectedIP", fmt.Errorf("invalid value for `ExpectedIP`, must contain non-empty string"))
+	} else if net.ParseIP(ck.ExpectedIP) == nil {
+		v.add("ExpectedIP", fmt.Errorf("invalid value %q for `ExpectedIP`, must be an IP address", ck.ExpectedIP))
 	}
 
 	if ck.NameServer == "" {
-		return fmt.Errorf("invalid value for `NameServer`, must contain non-empty string")
+		v.add("NameServer", fmt.Errorf("invalid value for `NameServer`, must contain non-empty string"))
 	}
 
-	return nil
+	return v.err()
 }
 
 func intListToCDString(integers []int) string {
@@ -412,22 +858,61 @@ func intListToCDString(integers []int) string {
 	return CDString
 }
 
-func validCommonParameters(name string, hostname string, resolution int) error {
+// MaxCustomMessageLength is the maximum number of characters of the custom
+// message of a check.  Pingdom silently truncates longer messages.
+const MaxCustomMessageLength = 1000
+
+// validCustomMessage determines whether the custom message of a check fits
+// within MaxCustomMessageLength.
+func validCustomMessage(message string) error {
+	if n := utf8.RuneCountInString(message); n > MaxCustomMessageLength {
+		return fmt.Errorf("invalid value for `CustomMessage`, %d characters exceed the limit of %d", n, MaxCustomMessageLength)
+	}
+	return nil
+}
+
+// validNotificationSettings records the invalid alerting settings shared by
+// all check types.
+func validNotificationSettings(v *ValidationError, sendNotificationWhenDown int, notifyAgainEvery int) {
+	if sendNotificationWhenDown < 0 {
+		v.add("SendNotificationWhenDown", fmt.Errorf("invalid value %d for `SendNotificationWhenDown`, must not be negative", sendNotificationWhenDown))
+	}
+
+	v.add("NotifyAgainEvery", validNotifyAgainEvery(notifyAgainEvery))
+}
+
+// validNotifyAgainEvery determines whether n is a usable number of failed
+// results between repeated alerts.
+func validNotifyAgainEvery(n int) error {
+	if n < 0 {
+		return fmt.Errorf("invalid value %d for `NotifyAgainEvery`, must not be negative", n)
+	}
+	return nil
+}
+
+// validCommonParameters records the invalid parameters shared by all check
+// types.
+func validCommonParameters(v *ValidationError, name string, hostname string, resolution int) {
 	if name == "" {
-		return fmt.Errorf("invalid value for `Name`, must contain non-empty string")
+		v.add("Name", fmt.Errorf("invalid value for `Name`, must contain non-empty string"))
 	}
 
 	if hostname == "" {
-		return fmt.Errorf("invalid value for `Hostname`, must contain non-empty string")
+		v.add("Hostname", fmt.Errorf("invalid value for `Hostname`, must contain non-empty string"))
 	}
 
 	// if resolution value is 0, it will be set to default value which is 5.
 	if resolution != 0 && resolution != 1 && resolution != 5 && resolution != 15 &&
 		resolution != 30 && resolution != 60 {
-		return fmt.Errorf("invalid value %v for `Resolution`, allowed values are [1,5,15,30,60]", resolution)
+		v.add("Resolution", fmt.Errorf("invalid value %v for `Resolution`, allowed values are [1,5,15,30,60]", resolution))
 	}
+}
 
-	return nil
+// validPort records an invalid port of the check types which require one.
+func validPort(v *ValidationError, port int) {
+	if port < 1 || port > 65535 {
+		v.add("Port", fmt.Errorf("Invalid value for `Port`.  Must contain an integer >= 1 and <= 65535"))
+	}
 }
 
 // Valid determines whether a SummaryPerformanceRequest contains valid fields for the Pingdom API.
@@ -446,6 +931,14 @@ func (csr SummaryPerformanceRequest) Valid() error {
 func (csr SummaryPerformanceRequest) GetParams() (params map[string]string) {
 	params = make(map[string]string)
 
+	if csr.From != 0 {
+		params["from"] = strconv.Itoa(csr.From)
+	}
+
+	if csr.To != 0 {
+		params["to"] = strconv.Itoa(csr.To)
+	}
+
 	if csr.Resolution != "" {
 		params["resolution"] = csr.Resolution
 	}
@@ -456,3 +949,49 @@ func (csr SummaryPerformanceRequest) GetParams() (params map[string]string) {
 
 	return
 }
+
+// CheckListQuery holds the filters supported when listing checks.
+type CheckListQuery struct {
+	Limit  int
+	Offset int
+	// Tags restricts the list to the checks with at least one of the tags.
+	Tags []string
+	// IncludeTags makes Pingdom return the tags of each check.
+	IncludeTags bool
+}
+
+// Valid determines whether a CheckListQuery contains valid fields for the Pingdom API.
+func (q CheckListQuery) Valid() error {
+	if q.Limit < 0 {
+		return fmt.Errorf("invalid value for `Limit`, must not be negative")
+	}
+
+	if q.Offset < 0 {
+		return fmt.Errorf("invalid value for `Offset`, must not be negative")
+	}
+
+	return validTags(strings.Join(q.Tags, ","))
+}
+
+// GetParams returns a map of params for a Pingdom CheckListQuery.
+func (q CheckListQuery) GetParams() map[string]string {
+	params := make(map[string]string)
+
+	if q.Limit != 0 {
+		params["limit"] = strconv.Itoa(q.Limit)
+	}
+
+	if q.Offset != 0 {
+		params["offset"] = strconv.Itoa(q.Offset)
+	}
+
+	if tags := normalizeTags(strings.Join(q.Tags, ",")); tags != "" {
+		params["tags"] = tags
+	}
+
+	if q.IncludeTags {
+		params["include_tags"] = "true"
+	}
+
+	return params
+}
@@ -1,6 +1,8 @@
 package pingdom
 
 import (
+	"encoding/json"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -159,6 +161,84 @@ func TestHttpCheckPostParams(t *testing.T) {
 	assert.Equal(t, want, params)
 }
 
+func TestHttpCheckPostParamsWithPostData(t *testing.T) {
+	check := HttpCheck{
+		Name:           "fake check",
+		Hostname:       "example.com",
+		Url:            "/api/search",
+		PostData:       `{"query":"status"}`,
+		ContentType:    "application/json",
+		RequestHeaders: map[string]string{"X-Env": "prod"},
+	}
+	assert.NoError(t, check.Valid())
+
+	params := check.PostParams()
+	assert.Equal(t, `{"query":"status"}`, params["postdata"])
+	assert.Equal(t, "Content-Type:application/json", params["requestheader0"])
+	assert.Equal(t, "X-Env:prod", params["requestheader1"])
+	assert.Equal(t, map[string]string{"X-Env": "prod"}, check.RequestHeaders)
+
+	noPostData := HttpCheck{Name: "fake check", Hostname: "example.com", ContentType: "application/json"}
+	assert.Error(t, noPostData.Valid())
+
+	duplicateContentType := check
+	duplicateContentType.RequestHeaders = map[string]string{"content-type": "text/plain"}
+	assert.Error(t, duplicateContentType.Valid())
+}
+
+func TestHttpCheckContentMatchingParams(t *testing.T) {
+	tests := []struct {
+		name       string
+		giveCheck  HttpCheck
+		wantPut    map[string]string
+		wantPost   map[string]string
+		wantAbsent string
+	}{
+		{
+			name:       "should contain",
+			giveCheck:  HttpCheck{Name: "fake check", Hostname: "example.com", ShouldContain: "Welcome"},
+			wantPut:    map[string]string{"shouldcontain": "Welcome"},
+			wantPost:   map[string]string{"shouldcontain": "Welcome"},
+			wantAbsent: "shouldnotcontain",
+		},
+		{
+			name:       "should not contain",
+			giveCheck:  HttpCheck{Name: "fake check", Hostname: "example.com", ShouldNotContain: "Down for maintenance"},
+			wantPut:    map[string]string{"shouldnotcontain": "Down for maintenance"},
+			wantPost:   map[string]string{"shouldnotcontain": "Down for maintenance"},
+			wantAbsent: "shouldcontain",
+		},
+		{
+			name:       "neither clears on update",
+			giveCheck:  HttpCheck{Name: "fake check", Hostname: "example.com"},
+			wantPut:    map[string]string{"shouldnotcontain": ""},
+			wantPost:   map[string]string{},
+			wantAbsent: "shouldcontain",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.NoError(t, tt.giveCheck.Valid())
+
+			put := tt.giveCheck.PutParams()
+			for k, v := range tt.wantPut {
+				assert.Equal(t, v, put[k])
+			}
+			assert.NotContains(t, put, tt.wantAbsent)
+
+			post := tt.giveCheck.PostParams()
+			for k, v := range tt.wantPost {
+				assert.Equal(t, v, post[k])
+			}
+			assert.NotContains(t, post, tt.wantAbsent)
+			if len(tt.wantPost) == 0 {
+				assert.NotContains(t, post, "shouldnotcontain")
+			}
+		})
+	}
+}
+
 func TestHttpCheckValid(t *testing.T) {
 	check := HttpCheck{Name: "fake check", Hostname: "example.com"}
 	assert.NoError(t, check.Valid())
@@ -174,6 +254,28 @@ func TestHttpCheckValid(t *testing.T) {
 		ShouldNotContain: "bar",
 	}
 	assert.Error(t, badContainsCheck.Valid())
+
+	sslDownDaysBefore := -1
+	badSSLCheck := HttpCheck{
+		Name:              "fake check",
+		Hostname:          "example.com",
+		SSLDownDaysBefore: &sslDownDaysBefore,
+	}
+	assert.Error(t, badSSLCheck.Valid())
+
+	badHeaderCheck := HttpCheck{
+		Name:           "fake check",
+		Hostname:       "example.com",
+		RequestHeaders: map[string]string{"X-Env:prod": "1"},
+	}
+	assert.Error(t, badHeaderCheck.Valid())
+
+	badTagsCheck := HttpCheck{Name: "fake check", Hostname: "example.com", Tags: "prod,front end"}
+	assert.Error(t, badTagsCheck.Valid())
+
+	upperTagsCheck := HttpCheck{Name: "fake check", Hostname: "example.com", Tags: "Prod, EU"}
+	assert.NoError(t, upperTagsCheck.Valid())
+	assert.Equal(t, "prod,eu", upperTagsCheck.PostParams()["tags"])
 }
 
 func TestPingCheckPostParams(t *testing.T) {
@@ -184,10 +286,12 @@ func TestPingCheckPostParams(t *testing.T) {
 		UserIds:               []int{123, 456},
 		TeamIds:               []int{789},
 		ResponseTimeThreshold: 2300,
+		IPV6:                  true,
 	}
 	want := map[string]string{
 		"name":                   "fake check",
 		"host":                   "example.com",
+		"ipv6":                   "true",
 		"paused":                 "false",
 		"notifyagainevery":       "0",
 		"notifywhenbackup":       "false",
@@ -215,11 +319,13 @@ func TestPingCheckPutParams(t *testing.T) {
 		"name":             "fake check",
 		"host":             "example.com",
 		"resolution":       "5",
+		"ipv6":             "false",
 		"paused":           "false",
 		"notifyagainevery": "0",
 		"notifywhenbackup": "false",
 		"integrationids":   "33333333,44444444",
 		"probe_filters":    "",
+		"tags":             "",
 		"userids":          "123,456",
 		"teamids":          "789",
 	}
@@ -234,6 +340,14 @@ func TestPingCheckValid(t *testing.T) {
 
 	badCheck := PingCheck{Name: "fake check", Resolution: 10}
 	assert.Error(t, badCheck.Valid())
+
+	badTagsCheck := PingCheck{Name: "fake check", Hostname: "example.com", Tags: "prod,front end"}
+	assert.Error(t, badTagsCheck.Valid())
+
+	upperTagsCheck := PingCheck{Name: "fake check", Hostname: "example.com", Tags: "Prod, EU"}
+	assert.NoError(t, upperTagsCheck.Valid())
+	assert.Equal(t, "prod,eu", upperTagsCheck.PostParams()["tags"])
+	assert.Equal(t, "prod,eu", upperTagsCheck.PutParams()["tags"])
 }
 
 func TestTCPCheckPostParams(t *testing.T) {
@@ -301,11 +415,13 @@ func TestDNSCheckPutParams(t *testing.T) {
 				ProbeFilters:             "region: NA",
 				UserIds:                  []int{123, 456},
 				TeamIds:                  []int{789},
+				ResponseTimeThreshold:    2300,
 			},
 			wantParams: map[string]string{
 				"name":                     "fake check",
 				"host":                     "example.com",
 				"expectedip":               "192.168.1.1",
+				"responsetime_threshold":   "2300",
 				"nameserver":               "8.8.8.8",
 				"paused":                   "false",
 				"resolution":               "10",
@@ -392,13 +508,30 @@ func TestDNSCheckValid(t *testing.T) {
 
 	badNameServerCheck := DNSCheck{Name: "fake check", Hostname: "example.com", ExpectedIP: "192.168.0.1"}
 	assert.Error(t, badNameServerCheck.Valid())
+
+	ipv6Check := DNSCheck{Name: "fake check", Hostname: "example.com", ExpectedIP: "2001:db8::1", NameServer: "8.8.8.8"}
+	assert.NoError(t, ipv6Check.Valid())
+
+	badExpectedIPCheck := DNSCheck{Name: "fake check", Hostname: "example.com", ExpectedIP: "example.org", NameServer: "8.8.8.8"}
+	assert.Error(t, badExpectedIPCheck.Valid())
 }
 
 func TestValidCommonParameters(t *testing.T) {
-	assert.Error(t, validCommonParameters("", "example.com", 5))
-	assert.Error(t, validCommonParameters("Test Name", "", 5))
-	assert.Error(t, validCommonParameters("Test Name", "example.com", 7))
-	assert.NoError(t, validCommonParameters("Test Name", "example.com", 0))
+	fields := func(name string, hostname string, resolution int) []string {
+		v := &ValidationError{}
+		validCommonParameters(v, name, hostname, resolution)
+		var fields []string
+		for _, f := range v.Fields {
+			fields = append(fields, f.Field)
+		}
+		return fields
+	}
+
+	assert.Equal(t, []string{"Name"}, fields("", "example.com", 5))
+	assert.Equal(t, []string{"Hostname"}, fields("Test Name", "", 5))
+	assert.Equal(t, []string{"Resolution"}, fields("Test Name", "example.com", 7))
+	assert.Equal(t, []string{"Name", "Hostname", "Resolution"}, fields("", "", 7))
+	assert.Empty(t, fields("Test Name", "example.com", 0))
 }
 
 func TestSummaryPerformanceRequestValid(t *testing.T) {
@@ -453,4 +586,245 @@ func TestSummaryPerformanceRequestGetParams(t *testing.T) {
 
 		assert.Equal(t, want, params)
 	})
+
+	t.Run("with time window", func(t *testing.T) {
+		want := map[string]string{
+			"from": "1563300000",
+			"to":   "1563386400",
+		}
+
+		params := SummaryPerformanceRequest{
+			Id:   id,
+			From: 1563300000,
+			To:   1563386400,
+		}.GetParams()
+
+		assert.Equal(t, want, params)
+	})
+}
+
+func TestNotifyAgainAfterFailures(t *testing.T) {
+	check := HttpCheck{
+		Name:             "fake check",
+		Hostname:         "example.com",
+		NotifyAgainEvery: NotifyAgainAfterFailures(3),
+	}
+	assert.NoError(t, check.Valid())
+	assert.Equal(t, "3", check.PutParams()["notifyagainevery"])
+
+	var resp CheckResponse
+	err := json.Unmarshal([]byte(`{"id": 1, "notifyagainevery": 3}`), &resp)
+	assert.NoError(t, err)
+	assert.Equal(t, NotifyAgainAfterFailures(3), resp.NotifyAgainEvery)
+	assert.Equal(t, 3, resp.NotifyAgainEvery)
+
+	failures := 2
+	check.NotifyAgainEvery = NotifyAgainAfterFailures(failures)
+	assert.NoError(t, check.Valid())
+
+	check.NotifyAgainEvery = NotifyAgainAfterFailures(-1)
+	assert.Error(t, check.Valid())
+	assert.Error(t, (&PingCheck{Name: "fake check", Hostname: "example.com", NotifyAgainEvery: -1}).Valid())
+}
+
+func TestSMTPCheckParams(t *testing.T) {
+	check := SMTPCheck{
+		Name:           "fake check",
+		Hostname:       "mail.example.com",
+		Port:           587,
+		Encryption:     true,
+		Username:       "monitor",
+		Password:       "secret",
+		StringToExpect: "220",
+		Resolution:     5,
+	}
+	assert.NoError(t, check.Valid())
+
+	assert.Equal(t, map[string]string{
+		"name":             "fake check",
+		"host":             "mail.example.com",
+		"port":             "587",
+		"encryption":       "true",
+		"auth":             "monitor:secret",
+		"stringtoexpect":   "220",
+		"resolution":       "5",
+		"ipv6":             "false",
+		"notifyagainevery": "0",
+		"notifywhenbackup": "false",
+		"paused":           "false",
+		"type":             "smtp",
+	}, check.PostParams())
+
+	put := check.PutParams()
+	assert.Equal(t, "", put["custom_message"])
+	assert.NotContains(t, put, "type")
+}
+
+func TestSMTPCheckValid(t *testing.T) {
+	assert.Error(t, (&SMTPCheck{Name: "fake check", Hostname: "mail.example.com"}).Valid())
+	assert.Error(t, (&SMTPCheck{Name: "fake check", Port: 25}).Valid())
+	assert.Error(t, (&SMTPCheck{Name: "fake check", Hostname: "mail.example.com", Port: 25, Password: "secret"}).Valid())
+	assert.NoError(t, (&SMTPCheck{Name: "fake check", Hostname: "mail.example.com", Port: 25}).Valid())
+}
+
+func TestIMAPCheckParams(t *testing.T) {
+	check := IMAPCheck{
+		Name:           "fake check",
+		Hostname:       "mail.example.com",
+		Port:           993,
+		Encryption:     true,
+		StringToExpect: "* OK",
+	}
+	assert.NoError(t, check.Valid())
+
+	assert.Equal(t, map[string]string{
+		"name":             "fake check",
+		"host":             "mail.example.com",
+		"port":             "993",
+		"encryption":       "true",
+		"stringtoexpect":   "* OK",
+		"ipv6":             "false",
+		"notifyagainevery": "0",
+		"notifywhenbackup": "false",
+		"paused":           "false",
+		"type":             "imap",
+	}, check.PostParams())
+
+	assert.Error(t, (&IMAPCheck{Name: "fake check", Hostname: "mail.example.com"}).Valid())
+}
+
+func TestPOP3CheckParams(t *testing.T) {
+	check := POP3Check{
+		Name:           "fake check",
+		Hostname:       "mail.example.com",
+		Port:           110,
+		StringToExpect: "+OK",
+		Tags:           "Mail",
+	}
+	assert.NoError(t, check.Valid())
+
+	assert.Equal(t, map[string]string{
+		"name":             "fake check",
+		"host":             "mail.example.com",
+		"port":             "110",
+		"encryption":       "false",
+		"stringtoexpect":   "+OK",
+		"tags":             "mail",
+		"ipv6":             "false",
+		"notifyagainevery": "0",
+		"notifywhenbackup": "false",
+		"paused":           "false",
+		"type":             "pop3",
+	}, check.PostParams())
+
+	assert.Error(t, (&POP3Check{Name: "fake check", Hostname: "mail.example.com", Port: 70000}).Valid())
+}
+
+func TestUDPCheckValid(t *testing.T) {
+	check := UDPCheck{Name: "fake check", Hostname: "example.com", Port: 53, StringToSend: "ping", StringToExpect: "pong"}
+	assert.NoError(t, check.Valid())
+
+	noPortCheck := UDPCheck{Name: "fake check", Hostname: "example.com", StringToSend: "ping", StringToExpect: "pong"}
+	assert.Error(t, noPortCheck.Valid())
+
+	noStringsCheck := UDPCheck{Name: "fake check", Hostname: "example.com", Port: 53}
+	assert.Error(t, noStringsCheck.Valid())
+}
+
+func TestCheckNotificationSettings(t *testing.T) {
+	checks := []Check{
+		&HttpCheck{Name: "fake check", Hostname: "example.com", SendNotificationWhenDown: 3, NotifyAgainEvery: 10, NotifyWhenBackup: true},
+		&PingCheck{Name: "fake check", Hostname: "example.com", SendNotificationWhenDown: 3, NotifyAgainEvery: 10, NotifyWhenBackup: true},
+		&TCPCheck{Name: "fake check", Hostname: "example.com", Port: 25, SendNotificationWhenDown: 3, NotifyAgainEvery: 10, NotifyWhenBackup: true},
+		&DNSCheck{Name: "fake check", Hostname: "example.com", ExpectedIP: "192.168.1.1", NameServer: "8.8.8.8", SendNotificationWhenDown: 3, NotifyAgainEvery: 10, NotifyWhenBackup: true},
+	}
+
+	for _, check := range checks {
+		assert.NoError(t, check.Valid())
+		for _, params := range []map[string]string{check.PutParams(), check.PostParams()} {
+			assert.Equal(t, "3", params["sendnotificationwhendown"])
+			assert.Equal(t, "10", params["notifyagainevery"])
+			assert.Equal(t, "true", params["notifywhenbackup"])
+		}
+	}
+
+	badCheck := HttpCheck{Name: "fake check", Hostname: "example.com", SendNotificationWhenDown: -1}
+	assert.Error(t, badCheck.Valid())
+}
+
+func TestCheckCustomMessageLength(t *testing.T) {
+	longMessage := strings.Repeat("é", MaxCustomMessageLength+1)
+
+	check := HttpCheck{Name: "fake check", Hostname: "example.com", CustomMessage: longMessage[:2*MaxCustomMessageLength]}
+	assert.NoError(t, check.Valid())
+
+	badHTTPCheck := HttpCheck{Name: "fake check", Hostname: "example.com", CustomMessage: longMessage}
+	assert.EqualError(t, badHTTPCheck.Valid(), "invalid value for `CustomMessage`, 1001 characters exceed the limit of 1000")
+
+	badTCPCheck := TCPCheck{Name: "fake check", Hostname: "example.com", Port: 25, CustomMessage: longMessage}
+	assert.Error(t, badTCPCheck.Valid())
+}
+
+func TestCheckCustomMessageParams(t *testing.T) {
+	message := "Runbook: https://wiki.example.com/runbooks/web"
+	checks := []Check{
+		&HttpCheck{Name: "fake check", Hostname: "example.com", CustomMessage: message},
+		&TCPCheck{Name: "fake check", Hostname: "example.com", Port: 25, CustomMessage: message},
+		&UDPCheck{Name: "fake check", Hostname: "example.com", Port: 53, StringToSend: "a", StringToExpect: "b", CustomMessage: message},
+		&SMTPCheck{Name: "fake check", Hostname: "example.com", Port: 25, CustomMessage: message},
+		&IMAPCheck{Name: "fake check", Hostname: "example.com", Port: 143, CustomMessage: message},
+		&POP3Check{Name: "fake check", Hostname: "example.com", Port: 110, CustomMessage: message},
+	}
+	for _, check := range checks {
+		assert.NoError(t, check.Valid())
+		assert.Equal(t, message, check.PostParams()["custom_message"])
+		assert.Equal(t, message, check.PutParams()["custom_message"])
+	}
+}
+
+func TestCheckAlertTargets(t *testing.T) {
+	userIDs, teamIDs, integrationIDs := []int{1, 2}, []int{3}, []int{4, 5, 6}
+	checks := []Check{
+		&HttpCheck{Name: "fake check", Hostname: "example.com", UserIds: userIDs, TeamIds: teamIDs, IntegrationIds: integrationIDs},
+		&PingCheck{Name: "fake check", Hostname: "example.com", UserIds: userIDs, TeamIds: teamIDs, IntegrationIds: integrationIDs},
+		&TCPCheck{Name: "fake check", Hostname: "example.com", Port: 25, UserIds: userIDs, TeamIds: teamIDs, IntegrationIds: integrationIDs},
+		&UDPCheck{Name: "fake check", Hostname: "example.com", Port: 53, UserIds: userIDs, TeamIds: teamIDs, IntegrationIds: integrationIDs},
+		&DNSCheck{Name: "fake check", Hostname: "example.com", ExpectedIP: "192.168.1.1", NameServer: "8.8.8.8", UserIds: userIDs, TeamIds: teamIDs, IntegrationIds: integrationIDs},
+		&SMTPCheck{Name: "fake check", Hostname: "example.com", Port: 25, UserIds: userIDs, TeamIds: teamIDs, IntegrationIds: integrationIDs},
+		&IMAPCheck{Name: "fake check", Hostname: "example.com", Port: 143, UserIds: userIDs, TeamIds: teamIDs, IntegrationIds: integrationIDs},
+		&POP3Check{Name: "fake check", Hostname: "example.com", Port: 110, UserIds: userIDs, TeamIds: teamIDs, IntegrationIds: integrationIDs},
+	}
+
+	for _, check := range checks {
+		for _, params := range []map[string]string{check.PutParams(), check.PostParams()} {
+			assert.Equal(t, "1,2", params["userids"])
+			assert.Equal(t, "3", params["teamids"])
+			assert.Equal(t, "4,5,6", params["integrationids"])
+		}
+	}
+
+	// Updating a check without targets clears them, while creating one
+	// leaves the params out.
+	check := HttpCheck{Name: "fake check", Hostname: "example.com"}
+	assert.Equal(t, "", check.PutParams()["userids"])
+	assert.Contains(t, check.PutParams(), "userids")
+	assert.NotContains(t, check.PostParams(), "userids")
+}
+
+func TestCheckListQueryValid(t *testing.T) {
+	assert.NoError(t, CheckListQuery{}.Valid())
+	assert.NoError(t, CheckListQuery{Limit: 10, Offset: 20, Tags: []string{"prod", "web"}}.Valid())
+	assert.Error(t, CheckListQuery{Limit: -1}.Valid())
+	assert.Error(t, CheckListQuery{Offset: -1}.Valid())
+	assert.Error(t, CheckListQuery{Tags: []string{"bad tag"}}.Valid())
+}
+
+func TestCheckListQueryGetParams(t *testing.T) {
+	assert.Equal(t, map[string]string{}, CheckListQuery{}.GetParams())
+	assert.Equal(t, map[string]string{
+		"limit":        "10",
+		"offset":       "20",
+		"tags":         "prod,web",
+		"include_tags": "true",
+	}, CheckListQuery{Limit: 10, Offset: 20, Tags: []string{" Prod", "web"}, IncludeTags: true}.GetParams())
 }
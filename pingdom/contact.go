@@ -1,9 +1,7 @@
 package pingdom
 
 import (
-	"encoding/json"
 	"fmt"
-	"io/ioutil"
 	"strconv"
 )
 
@@ -26,23 +24,11 @@ func (cs *ContactService) List() ([]Contact, error) {
 		return nil, err
 	}
 
-	resp, err := cs.client.client.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	if err := validateResponse(resp); err != nil {
+	u := &listContactsJSONResponse{}
+	if _, err := cs.client.doStream(req, u); err != nil {
 		return nil, err
 	}
-
-	bodyBytes, _ := ioutil.ReadAll(resp.Body)
-	bodyString := string(bodyBytes)
-
-	u := &listContactsJSONResponse{}
-	err = json.Unmarshal([]byte(bodyString), &u)
-
-	return u.Contacts, err
+	return u.Contacts, nil
 }
 
 // Read return a contact object from Pingdom.
@@ -57,6 +43,9 @@ func (cs *ContactService) Read(contactID int) (*Contact, error) {
 	if err != nil {
 		return nil, err
 	}
+	if c.Contact == nil {
+		return nil, ErrEmptyResponse
+	}
 
 	return c.Contact, nil
 }
@@ -67,6 +56,10 @@ func (cs *ContactService) Create(contact ContactAPI) (*Contact, error) {
 		return nil, err
 	}
 
+	if err := cs.client.validContactReferences(contact); err != nil {
+		return nil, err
+	}
+
 	req, err := cs.client.NewJSONRequest("POST", "/alerting/contacts", contact.RenderForJSONAPI())
 	if err != nil {
 		return nil, err
@@ -87,6 +80,10 @@ func (cs *ContactService) Update(id int, contact ContactAPI) (*PingdomResponse,
 		return nil, err
 	}
 
+	if err := cs.client.validContactReferences(contact); err != nil {
+		return nil, err
+	}
+
 	req, err := cs.client.NewJSONRequest("PUT", "/alerting/contacts/"+strconv.Itoa(id), contact.RenderForJSONAPI())
 	if err != nil {
 		return nil, err
@@ -0,0 +1,21 @@
+package pingdom
+
+// CreditService provides an interface to the credits of the Pingdom account.
+type CreditService struct {
+	client *Client
+}
+
+// Get returns the check, SMS and RUM credits of the account.
+func (cs *CreditService) Get() (*Credits, error) {
+	req, err := cs.client.NewRequest("GET", "/credits", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	m := &creditsJSONResponse{}
+	_, err = cs.client.Do(req, m)
+	if err != nil {
+		return nil, err
+	}
+	return m.Credits, nil
+}
@@ -0,0 +1,81 @@
+package pingdom
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreditServiceGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/credits", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{
+			"credits": {
+				"checklimit": 100,
+				"availablechecks": 58,
+				"useddefault": 40,
+				"usedtransaction": 2,
+				"availablesms": 15,
+				"availablesmstests": 5,
+				"autofillsms": true,
+				"autofillsms_amount": 50,
+				"autofillsms_when_left": 10,
+				"max_sms_overage": 20,
+				"availablerumsites": 3,
+				"usedrum": 1,
+				"maxrumfilters": 5,
+				"maxrumpageviews": 100000
+			}
+		}`)
+	})
+	want := &Credits{
+		CheckLimit:          100,
+		AvailableChecks:     58,
+		UsedDefault:         40,
+		UsedTransaction:     2,
+		AvailableSMS:        15,
+		AvailableSMSTests:   5,
+		AutoFillSMS:         true,
+		AutoFillSMSAmount:   50,
+		AutoFillSMSWhenLeft: 10,
+		MaxSMSOverage:       20,
+		AvailableRUMSites:   3,
+		UsedRUM:             1,
+		MaxRUMFilters:       5,
+		MaxRUMPageViews:     100000,
+	}
+
+	credits, err := client.Credits.Get()
+	assert.NoError(t, err)
+	assert.Equal(t, want, credits)
+}
+
+func TestClientPing(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/credits", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if r.Header.Get("Authorization") != "Bearer my_api_key" {
+			w.WriteHeader(http.StatusUnauthorized)
+			fmt.Fprint(w, `{"error":{"statuscode":401,"statusdesc":"Unauthorized","errormessage":"Invalid token"}}`)
+			return
+		}
+		fmt.Fprint(w, `{"credits": {"checklimit": 100}}`)
+	})
+
+	assert.NoError(t, client.Ping())
+
+	client.APIToken = "bad_key"
+	err := client.Ping()
+	assert.True(t, errors.Is(err, ErrUnauthorized))
+	var pe *PingdomError
+	assert.True(t, errors.As(err, &pe))
+	assert.Equal(t, "Invalid token", pe.Message)
+}
@@ -0,0 +1,49 @@
+package pingdom
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+)
+
+// DryRunError is returned in place of sending a request that would modify the
+// account, when the client is in dry-run mode.  It describes the request that
+// would have been sent, with the credentials redacted.  Retrieve it with
+// errors.As.
+type DryRunError struct {
+	Method string
+	URL    string
+	Body   string
+}
+
+func (e *DryRunError) Error() string {
+	return fmt.Sprintf("pingdom: dry run, not sending %s %s", e.Method, e.URL)
+}
+
+// isMutating reports whether a request with the given method modifies the
+// account.
+func isMutating(method string) bool {
+	switch method {
+	case "GET", "HEAD", "OPTIONS":
+		return false
+	}
+	return true
+}
+
+// dryRun returns the DryRunError describing req.
+func dryRun(req *http.Request) error {
+	c, err := copyRequest(req)
+	if err != nil {
+		return err
+	}
+
+	e := &DryRunError{Method: c.Method, URL: c.URL.String()}
+	if c.Body != nil && c.Body != http.NoBody {
+		b, err := ioutil.ReadAll(c.Body)
+		if err != nil {
+			return err
+		}
+		e.Body = string(b)
+	}
+	return e
+}
@@ -0,0 +1,55 @@
+package pingdom
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientDryRun(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.dryRun = true
+
+	mux.HandleFunc("/checks/12345", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"check":{"id":12345,"name":"Example"}}`)
+	})
+	mux.HandleFunc("/checks", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("mutating request %s %s should not be sent", r.Method, r.URL)
+	})
+	mux.HandleFunc("/alerting/contacts", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("mutating request %s %s should not be sent", r.Method, r.URL)
+	})
+
+	check, err := client.Checks.Read(12345)
+	assert.NoError(t, err)
+	assert.Equal(t, "Example", check.Name)
+
+	_, err = client.Checks.Create(&HttpCheck{Name: "fake check", Hostname: "example.com"})
+	var dre *DryRunError
+	assert.True(t, errors.As(err, &dre))
+	assert.Equal(t, "POST", dre.Method)
+	assert.Contains(t, dre.URL, "/checks?")
+	assert.Contains(t, dre.URL, "name=fake+check")
+
+	_, err = client.Contacts.Create(&Contact{Name: "testContact"})
+	assert.True(t, errors.As(err, &dre))
+	assert.Equal(t, "POST", dre.Method)
+	assert.Contains(t, dre.Body, `"name":"testContact"`)
+
+	_, err = client.Checks.Delete(12345)
+	assert.True(t, errors.As(err, &dre))
+	assert.Equal(t, "DELETE", dre.Method)
+	assert.Equal(t, server.URL+"/checks/12345", dre.URL)
+}
+
+func TestWithDryRun(t *testing.T) {
+	c, err := NewClientWithConfig(ClientConfig{APIToken: "key", DryRun: true})
+	assert.NoError(t, err)
+	assert.True(t, c.dryRun)
+}
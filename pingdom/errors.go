@@ -0,0 +1,66 @@
+package pingdom
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+)
+
+// ErrUnauthorized is matched by a PingdomError for a 401 response, which
+// usually means the API token is missing or invalid.
+var ErrUnauthorized = errors.New("pingdom: unauthorized")
+
+// ErrForbidden is matched by a PingdomError for a 403 response.
+var ErrForbidden = errors.New("pingdom: forbidden")
+
+// ErrNotFound is matched by a PingdomError for a 404 response.
+var ErrNotFound = errors.New("pingdom: not found")
+
+// ErrDuplicateName is matched by a PingdomError rejecting a create because
+// a resource with the same name already exists.
+var ErrDuplicateName = errors.New("pingdom: duplicate name")
+
+// ErrEmptyResponse is returned when a successful response does not hold the
+// resource that was requested, e.g. when its body is empty.
+var ErrEmptyResponse = errors.New("pingdom: empty response")
+
+// Unwrap returns the sentinel error matching the HTTP status of the
+// PingdomError, if any, so callers can branch on it with errors.Is, e.g.
+//
+//	if errors.Is(err, pingdom.ErrNotFound) { ... }
+//
+// The PingdomError itself, with its status code, can be retrieved with
+// errors.As.
+func (r *PingdomError) Unwrap() error {
+	switch r.StatusCode {
+	case http.StatusUnauthorized:
+		return ErrUnauthorized
+	case http.StatusForbidden:
+		return ErrForbidden
+	case http.StatusNotFound:
+		return ErrNotFound
+	}
+	return nil
+}
+
+// Is reports whether the PingdomError is a rejection of a duplicate name, for
+// errors.Is(err, pingdom.ErrDuplicateName).  The status sentinels are matched
+// through Unwrap.
+func (r *PingdomError) Is(target error) bool {
+	if target == ErrDuplicateName {
+		return (r.StatusCode == http.StatusBadRequest || r.StatusCode == http.StatusConflict) &&
+			strings.Contains(strings.ToLower(r.Message), "already exists")
+	}
+	return false
+}
+
+// isOffsetOutOfRange reports whether err is Pingdom rejecting the offset of
+// a paginated request, which it does once the offset exceeds the maximum the
+// endpoint allows.  Pagination helpers treat this as the end of the data.
+func isOffsetOutOfRange(err error) bool {
+	var pe *PingdomError
+	if !errors.As(err, &pe) || pe.StatusCode != http.StatusBadRequest {
+		return false
+	}
+	return strings.Contains(strings.ToLower(pe.Message), "offset")
+}
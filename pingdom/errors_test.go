@@ -0,0 +1,124 @@
+package pingdom
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPingdomErrorIs(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		want       error
+		notWant    []error
+	}{
+		{
+			name:       "401 is unauthorized",
+			statusCode: 401,
+			want:       ErrUnauthorized,
+			notWant:    []error{ErrForbidden, ErrNotFound},
+		},
+		{
+			name:       "403 is forbidden",
+			statusCode: 403,
+			want:       ErrForbidden,
+			notWant:    []error{ErrUnauthorized, ErrNotFound},
+		},
+		{
+			name:       "404 is not found",
+			statusCode: 404,
+			want:       ErrNotFound,
+			notWant:    []error{ErrUnauthorized, ErrForbidden},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var err error = &PingdomError{StatusCode: tt.statusCode}
+			assert.True(t, errors.Is(err, tt.want))
+			for _, e := range tt.notWant {
+				assert.False(t, errors.Is(err, e))
+			}
+
+			var pe *PingdomError
+			assert.True(t, errors.As(err, &pe))
+			assert.Equal(t, tt.statusCode, pe.StatusCode)
+		})
+	}
+}
+
+func TestCheckServiceReadNotFound(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/checks/12345", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"error":{"statuscode":404,"statusdesc":"Not Found","errormessage":"Check not found"}}`)
+	})
+
+	_, err := client.Checks.Read(12345)
+	assert.True(t, errors.Is(err, ErrNotFound))
+	assert.False(t, errors.Is(err, ErrUnauthorized))
+
+	wrapped := fmt.Errorf("reading check: %w", err)
+	assert.True(t, errors.Is(wrapped, ErrNotFound))
+
+	var pe *PingdomError
+	assert.True(t, errors.As(wrapped, &pe))
+	assert.Equal(t, 404, pe.StatusCode)
+	assert.Equal(t, "Check not found", pe.Message)
+}
+
+func TestContactServiceReadNotFound(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/alerting/contacts/12345", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"error":{"statuscode":404,"statusdesc":"Not Found","errormessage":"Contact not found"}}`)
+	})
+
+	contact, err := client.Contacts.Read(12345)
+	assert.Nil(t, contact)
+	assert.True(t, errors.Is(err, ErrNotFound))
+}
+
+func TestMaintenanceServiceReadNotFound(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/maintenance/12345", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"error":{"statuscode":404,"statusdesc":"Not Found","errormessage":"Maintenance window not found"}}`)
+	})
+
+	maintenance, err := client.Maintenances.Read(12345)
+	assert.Nil(t, maintenance)
+	assert.True(t, errors.Is(err, ErrNotFound))
+
+	_, err = client.Maintenances.ReadWithOptions(12345, MaintenanceReadOptions{Resolve: true})
+	assert.True(t, errors.Is(err, ErrNotFound))
+}
+
+func TestCheckServiceCreateDuplicateName(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/checks", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"error":{"statuscode":400,"statusdesc":"Bad Request","errormessage":"A check with name 'My check' already exists"}}`)
+	})
+
+	_, err := client.Checks.Create(&HttpCheck{Name: "My check", Hostname: "example.com"})
+	assert.True(t, errors.Is(err, ErrDuplicateName))
+
+	var pe *PingdomError
+	assert.True(t, errors.As(err, &pe))
+	assert.Equal(t, 400, pe.StatusCode)
+
+	assert.False(t, errors.Is(&PingdomError{StatusCode: 400, Message: "Invalid parameter value: host"}, ErrDuplicateName))
+}
@@ -0,0 +1,106 @@
+package pingdom
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// etagCache keeps the body of GET responses carrying an ETag, per URL and
+// sub-account, so that they can be revalidated with If-None-Match and served
+// again on a 304 Not Modified.  Only the endpoints of etagPaths are cached,
+// since entries are never evicted.
+type etagCache struct {
+	mu      sync.Mutex
+	entries map[string]etagEntry
+}
+
+type etagEntry struct {
+	etag   string
+	header http.Header
+	body   []byte
+}
+
+func newETagCache() *etagCache {
+	return &etagCache{entries: map[string]etagEntry{}}
+}
+
+// etagPaths are the endpoints whose responses are cached: data that rarely
+// changes and is requested with a small, fixed set of parameters.
+var etagPaths = []string{"/probes", "/reference"}
+
+// cacheable reports whether the response of req may be cached.
+func cacheable(req *http.Request) bool {
+	if req.Method != "GET" {
+		return false
+	}
+	for _, path := range etagPaths {
+		if strings.HasSuffix(req.URL.Path, path) {
+			return true
+		}
+	}
+	return false
+}
+
+// etagKey identifies the cached response of a request.  Copies of a client
+// share its cache but may act on behalf of different sub-accounts.
+func etagKey(req *http.Request) string {
+	return req.Header.Get("Account-Email") + " " + req.URL.String()
+}
+
+// prepare adds an If-None-Match header to a GET request whose response is
+// cached, unless the caller set one.
+func (c *etagCache) prepare(req *http.Request) {
+	if !cacheable(req) || req.Header.Get("If-None-Match") != "" {
+		return
+	}
+	c.mu.Lock()
+	entry, ok := c.entries[etagKey(req)]
+	c.mu.Unlock()
+	if ok {
+		req.Header.Set("If-None-Match", entry.etag)
+	}
+}
+
+// update serves the cached body in place of a 304 Not Modified response, and
+// caches the body of a successful response carrying an ETag.
+func (c *etagCache) update(req *http.Request, resp *http.Response) error {
+	if !cacheable(req) {
+		return nil
+	}
+	key := etagKey(req)
+
+	if resp.StatusCode == http.StatusNotModified {
+		c.mu.Lock()
+		entry, ok := c.entries[key]
+		c.mu.Unlock()
+		if !ok {
+			return nil
+		}
+		resp.Body.Close()
+		resp.StatusCode = http.StatusOK
+		resp.Status = "200 OK"
+		resp.Header = entry.header.Clone()
+		resp.ContentLength = int64(len(entry.body))
+		resp.Body = ioutil.NopCloser(bytes.NewReader(entry.body))
+		return nil
+	}
+
+	etag := resp.Header.Get("ETag")
+	if resp.StatusCode != http.StatusOK || etag == "" {
+		return nil
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	c.mu.Lock()
+	c.entries[key] = etagEntry{etag: etag, header: resp.Header.Clone(), body: body}
+	c.mu.Unlock()
+	return nil
+}
@@ -0,0 +1,99 @@
+package pingdom
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientETagCache(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.etags = newETagCache()
+
+	calls := 0
+	mux.HandleFunc("/probes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		calls++
+		if calls > 1 {
+			assert.Equal(t, `"v1"`, r.Header.Get("If-None-Match"))
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		assert.Empty(t, r.Header.Get("If-None-Match"))
+		w.Header().Set("ETag", `"v1"`)
+		fmt.Fprint(w, `{"probes":[{"id":1,"country":"Sweden","active":true}]}`)
+	})
+
+	want := []ProbeResponse{{ID: 1, Country: "Sweden", Active: true}}
+	for i := 0; i < 3; i++ {
+		probes, err := client.Probes.List()
+		assert.NoError(t, err)
+		assert.Equal(t, want, probes)
+	}
+	assert.Equal(t, 3, calls)
+}
+
+func TestClientETagCacheSubAccounts(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.etags = newETagCache()
+	other := client.clone()
+	other.AccountEmail = "sub@example.com"
+
+	mux.HandleFunc("/probes", func(w http.ResponseWriter, r *http.Request) {
+		assert.Empty(t, r.Header.Get("If-None-Match"))
+		w.Header().Set("ETag", `"`+r.Header.Get("Account-Email")+`"`)
+		fmt.Fprint(w, `{"probes":[]}`)
+	})
+
+	_, err := client.Probes.List()
+	assert.NoError(t, err)
+	_, err = other.Probes.List()
+	assert.NoError(t, err)
+}
+
+func TestClientETagCacheSkipsResults(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.etags = newETagCache()
+
+	mux.HandleFunc("/results/1", func(w http.ResponseWriter, r *http.Request) {
+		assert.Empty(t, r.Header.Get("If-None-Match"))
+		w.Header().Set("ETag", `"v1"`)
+		fmt.Fprint(w, `{"results":[]}`)
+	})
+
+	for i := 0; i < 2; i++ {
+		_, err := client.Checks.Results(1, map[string]string{"from": fmt.Sprint(i)})
+		assert.NoError(t, err)
+	}
+	assert.Empty(t, client.etags.entries)
+}
+
+func TestClientNoETagCache(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/probes", func(w http.ResponseWriter, r *http.Request) {
+		assert.Empty(t, r.Header.Get("If-None-Match"))
+		w.Header().Set("ETag", `"v1"`)
+		fmt.Fprint(w, `{"probes":[]}`)
+	})
+
+	for i := 0; i < 2; i++ {
+		_, err := client.Probes.List()
+		assert.NoError(t, err)
+	}
+}
+
+func TestWithETagCache(t *testing.T) {
+	c, err := NewClientWithConfig(ClientConfig{APIToken: "key", ETagCache: true})
+	assert.NoError(t, err)
+	assert.NotNil(t, c.etags)
+}
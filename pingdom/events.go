@@ -0,0 +1,36 @@
+package pingdom
+
+import "time"
+
+// EventType identifies the kind of an Event.
+type EventType string
+
+const (
+	// EventRetry is emitted before a failed request is retried.
+	EventRetry EventType = "retry"
+	// EventRateLimit is emitted before a request rejected with a 429 is
+	// retried.
+	EventRateLimit EventType = "rate_limit"
+)
+
+// Event is a structured description of something the client does on its own
+// while sending a request, such as backing off before a retry.  It is meant
+// to be forwarded to a structured logger.
+type Event struct {
+	Type       EventType
+	Method     string
+	URL        string
+	Attempt    int
+	Reason     string
+	StatusCode int
+	Wait       time.Duration
+}
+
+// EventHandler receives the events emitted by a Client.
+type EventHandler func(Event)
+
+func (pc *Client) emit(e Event) {
+	if pc.EventHandler != nil {
+		pc.EventHandler(e)
+	}
+}
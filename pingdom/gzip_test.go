@@ -0,0 +1,62 @@
+package pingdom
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientGzipResponse(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/checks", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		assert.Equal(t, "gzip", r.Header.Get("Accept-Encoding"))
+
+		w.Header().Set("Content-Encoding", "gzip")
+		zw := gzip.NewWriter(w)
+		zw.Write([]byte(`{"checks":[{"id":85975,"name":"My check"}]}`))
+		zw.Close()
+	})
+
+	var hookBody []byte
+	client.OnResponse = func(resp *http.Response) {
+		hookBody, _ = ioutil.ReadAll(resp.Body)
+	}
+
+	checks, err := client.Checks.List()
+	assert.NoError(t, err)
+	assert.Equal(t, []CheckResponse{{ID: 85975, Name: "My check"}}, checks)
+	assert.Equal(t, `{"checks":[{"id":85975,"name":"My check"}]}`, string(hookBody))
+}
+
+func TestClientGzipFallback(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/checks/85975", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"check":{"id":85975,"name":"My check"}}`))
+	})
+
+	check, err := client.Checks.Read(85975)
+	assert.NoError(t, err)
+	assert.Equal(t, 85975, check.ID)
+}
+
+func TestDecompressEmptyBody(t *testing.T) {
+	resp := &http.Response{
+		Header: http.Header{"Content-Encoding": {"gzip"}},
+		Body:   ioutil.NopCloser(bytes.NewReader(nil)),
+	}
+	decompress(resp)
+
+	body, err := ioutil.ReadAll(resp.Body)
+	assert.NoError(t, err)
+	assert.Empty(t, body)
+	assert.Empty(t, resp.Header.Get("Content-Encoding"))
+}
@@ -0,0 +1,85 @@
+package pingdom
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"strings"
+)
+
+const redacted = "REDACTED"
+
+// copyRequest returns a copy of req that hooks can freely consume. The body
+// of req is preserved, and the Authorization header and the password of the
+// auth parameter of checks are redacted in the copy.
+func copyRequest(req *http.Request) (*http.Request, error) {
+	c := req.Clone(req.Context())
+	if req.Body != nil && req.Body != http.NoBody {
+		b, err := ioutil.ReadAll(req.Body)
+		if err != nil {
+			return nil, err
+		}
+		req.Body.Close()
+		req.Body = ioutil.NopCloser(bytes.NewReader(b))
+		c.Body = ioutil.NopCloser(bytes.NewReader(b))
+	}
+	if c.Header.Get("Authorization") != "" {
+		c.Header.Set("Authorization", redacted)
+	}
+	c.URL = redactedURL(req)
+	return c, nil
+}
+
+// redactedURL returns a copy of the URL of req with the password of the auth
+// parameter of HTTP and SMTP checks redacted.
+func redactedURL(req *http.Request) *url.URL {
+	u := *req.URL
+	if q := u.Query(); q.Get("auth") != "" {
+		username := strings.SplitN(q.Get("auth"), ":", 2)[0]
+		q.Set("auth", username+":"+redacted)
+		u.RawQuery = q.Encode()
+	}
+	return &u
+}
+
+// copyResponse returns a copy of resp that hooks can freely consume, leaving
+// the body of resp readable for decoding.
+func copyResponse(resp *http.Response) (*http.Response, error) {
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body.Close()
+	resp.Body = ioutil.NopCloser(bytes.NewReader(b))
+
+	c := *resp
+	c.Header = resp.Header.Clone()
+	c.Body = ioutil.NopCloser(bytes.NewReader(b))
+	return &c, nil
+}
+
+func logRequest(w io.Writer) func(*http.Request) {
+	return func(req *http.Request) {
+		dump, err := httputil.DumpRequest(req, true)
+		if err != nil {
+			fmt.Fprintf(w, "pingdom: failed to dump request: %v\n", err)
+			return
+		}
+		fmt.Fprintf(w, "%s\n", dump)
+	}
+}
+
+func logResponse(w io.Writer) func(*http.Response) {
+	return func(resp *http.Response) {
+		dump, err := httputil.DumpResponse(resp, true)
+		if err != nil {
+			fmt.Fprintf(w, "pingdom: failed to dump response: %v\n", err)
+			return
+		}
+		fmt.Fprintf(w, "%s\n", dump)
+	}
+}
@@ -0,0 +1,93 @@
+package pingdom
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientHooks(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/alerting/teams", func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		assert.Equal(t, `{"member_ids":null,"name":"Team"}`, string(body))
+		assert.Equal(t, "Bearer my_api_key", r.Header.Get("Authorization"))
+		fmt.Fprint(w, `{"team":{"id":1,"name":"Team"}}`)
+	})
+
+	var reqBody, respBody []byte
+	var reqAuth string
+	client.OnRequest = func(r *http.Request) {
+		reqAuth = r.Header.Get("Authorization")
+		reqBody, _ = ioutil.ReadAll(r.Body)
+	}
+	client.OnResponse = func(r *http.Response) {
+		respBody, _ = ioutil.ReadAll(r.Body)
+	}
+
+	team, err := client.Teams.Create(&Team{Name: "Team"})
+	assert.NoError(t, err)
+	assert.Equal(t, &TeamResponse{ID: 1, Name: "Team"}, team)
+	assert.Equal(t, redacted, reqAuth)
+	assert.Equal(t, `{"member_ids":null,"name":"Team"}`, string(reqBody))
+	assert.Equal(t, `{"team":{"id":1,"name":"Team"}}`, string(respBody))
+}
+
+func TestClientLogger(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/probes", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"probes":[]}`)
+	})
+
+	var buf bytes.Buffer
+	c, err := NewClientWithConfig(ClientConfig{
+		APIToken: "my_api_key",
+		Logger:   &buf,
+	})
+	assert.NoError(t, err)
+	c.BaseURL, _ = url.Parse(server.URL)
+
+	_, err = c.Probes.List()
+	assert.NoError(t, err)
+	assert.Contains(t, buf.String(), "GET /probes")
+	assert.Contains(t, buf.String(), "Authorization: "+redacted)
+	assert.NotContains(t, buf.String(), "my_api_key")
+	assert.Contains(t, buf.String(), `{"probes":[]}`)
+}
+
+func TestClientLoggerRedactsCheckPassword(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/checks", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "admin:s3cret", r.URL.Query().Get("auth"))
+		fmt.Fprint(w, `{"check":{"id":1,"name":"My check"}}`)
+	})
+
+	var buf bytes.Buffer
+	c, err := NewClientWithConfig(ClientConfig{
+		APIToken: "my_api_key",
+		Logger:   &buf,
+	})
+	assert.NoError(t, err)
+	c.BaseURL, _ = url.Parse(server.URL)
+
+	_, err = c.Checks.Create(&HttpCheck{
+		Name:     "My check",
+		Hostname: "example.com",
+		Username: "admin",
+		Password: "s3cret",
+	})
+	assert.NoError(t, err)
+	assert.Contains(t, buf.String(), "auth=admin%3A"+redacted)
+	assert.NotContains(t, buf.String(), "s3cret")
+}
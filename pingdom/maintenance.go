@@ -1,9 +1,9 @@
 package pingdom
 
 import (
-	"encoding/json"
-	"io/ioutil"
+	"fmt"
 	"strconv"
+	"time"
 )
 
 // MaintenanceService provides an interface to Pingdom maintenance windows.
@@ -39,23 +39,11 @@ func (cs *MaintenanceService) List(params ...map[string]string) ([]MaintenanceRe
 		return nil, err
 	}
 
-	resp, err := cs.client.client.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	if err := validateResponse(resp); err != nil {
+	m := &listMaintenanceJSONResponse{}
+	if _, err := cs.client.doStream(req, m); err != nil {
 		return nil, err
 	}
-
-	bodyBytes, _ := ioutil.ReadAll(resp.Body)
-	bodyString := string(bodyBytes)
-
-	m := &listMaintenanceJSONResponse{}
-	err = json.Unmarshal([]byte(bodyString), &m)
-
-	return m.Maintenances, err
+	return m.Maintenances, nil
 }
 
 // Read returns a Maintenance for a given ID.
@@ -70,10 +58,62 @@ func (cs *MaintenanceService) Read(id int) (*MaintenanceResponse, error) {
 	if err != nil {
 		return nil, err
 	}
+	if m.Maintenance == nil {
+		return nil, ErrEmptyResponse
+	}
 
 	return m.Maintenance, err
 }
 
+// ReadWithOptions returns a Maintenance for a given ID, like Read.  With
+// Resolve set, it also looks up the names of the covered checks, listing
+// each kind of check at most once.  Covered checks that were deleted are left
+// out of the names.
+func (cs *MaintenanceService) ReadWithOptions(id int, opts MaintenanceReadOptions) (*MaintenanceResponse, error) {
+	maintenance, err := cs.Read(id)
+	if err != nil || !opts.Resolve {
+		return maintenance, err
+	}
+
+	covered := &maintenance.Checks
+	if len(covered.Uptime) != 0 {
+		checks, err := cs.client.Checks.List()
+		if err != nil {
+			return nil, err
+		}
+		names := make(map[int]string, len(checks))
+		for _, check := range checks {
+			names[check.ID] = check.Name
+		}
+		covered.UptimeNames = coveredNames(covered.Uptime, names)
+	}
+
+	if len(covered.Tms) != 0 {
+		checks, err := cs.client.TMSCheck.List()
+		if err != nil {
+			return nil, err
+		}
+		names := make(map[int]string, len(checks))
+		for _, check := range checks {
+			names[check.ID] = check.Name
+		}
+		covered.TmsNames = coveredNames(covered.Tms, names)
+	}
+
+	return maintenance, nil
+}
+
+// coveredNames returns the names of the given check IDs that are known.
+func coveredNames(ids []int, names map[int]string) map[int]string {
+	m := make(map[int]string, len(ids))
+	for _, id := range ids {
+		if name, ok := names[id]; ok {
+			m[id] = name
+		}
+	}
+	return m
+}
+
 // Create creates a new Maintenance.
 func (cs *MaintenanceService) Create(maintenance Maintenance) (*MaintenanceResponse, error) {
 	if err := maintenance.Valid(); err != nil {
@@ -86,13 +126,38 @@ func (cs *MaintenanceService) Create(maintenance Maintenance) (*MaintenanceRespo
 	}
 
 	m := &maintenanceDetailsJSONResponse{}
-	_, err = cs.client.Do(req, m)
+	resp, err := cs.client.Do(req, m)
 	if err != nil {
 		return nil, err
 	}
+	if m.Maintenance != nil {
+		m.Maintenance.Location = location(resp)
+	}
 	return m.Maintenance, err
 }
 
+// CreateImmediate creates a maintenance window covering the given uptime
+// checks, starting now and lasting for the given duration, e.g. to silence
+// alerts during an emergency.  The window starts at the current second and
+// its end is rounded up to the next whole second.
+func (cs *MaintenanceService) CreateImmediate(checkIDs []int, duration time.Duration, description string) (*MaintenanceResponse, error) {
+	if len(checkIDs) == 0 {
+		return nil, fmt.Errorf("invalid value for `checkIDs`, must contain at least one check ID")
+	}
+	if duration <= 0 {
+		return nil, fmt.Errorf("invalid value %v for `duration`, must be positive", duration)
+	}
+
+	from := cs.client.now().UTC().Unix()
+	seconds := int64((duration + time.Second - 1) / time.Second)
+	return cs.Create(&MaintenanceWindow{
+		Description: description,
+		From:        from,
+		To:          from + seconds,
+		UptimeIDs:   intListToCDString(checkIDs),
+	})
+}
+
 // Update is used to update an existing Maintenance. Only the 'Description',
 // and 'To' fields can be updated.
 func (cs *MaintenanceService) Update(id int, maintenance Maintenance) (*PingdomResponse, error) {
@@ -113,6 +178,35 @@ func (cs *MaintenanceService) Update(id int, maintenance Maintenance) (*PingdomR
 	return m, err
 }
 
+// UpdateChecks changes the checks associated with an existing Maintenance,
+// either replacing or appending to the current ones.  Passing an empty list
+// clears the association.
+func (cs *MaintenanceService) UpdateChecks(id int, checks MaintenanceChecks) (*PingdomResponse, error) {
+	if err := checks.Valid(); err != nil {
+		return nil, err
+	}
+
+	if checks.Append {
+		current, err := cs.Read(id)
+		if err != nil {
+			return nil, err
+		}
+		checks = *checks.merge(current.Checks)
+	}
+
+	req, err := cs.client.NewRequest("PUT", "/maintenance/"+strconv.Itoa(id), checks.PutParams())
+	if err != nil {
+		return nil, err
+	}
+
+	m := &PingdomResponse{}
+	_, err = cs.client.Do(req, m)
+	if err != nil {
+		return nil, err
+	}
+	return m, err
+}
+
 // MultiDelete will delete the Maintenance for the given ID.
 func (cs *MaintenanceService) MultiDelete(maintenance MaintenanceDelete) (*PingdomResponse, error) {
 	if err := maintenance.ValidDelete(); err != nil {
@@ -1,42 +1,39 @@
 package pingdom
 
 import (
-	"encoding/json"
 	"fmt"
-	"io/ioutil"
 	"strconv"
+	"time"
 )
 
+// OccurrenceService provides an interface to the occurrences of Pingdom
+// maintenance windows, i.e. the individual windows of a recurring
+// maintenance.  Occurrences can be listed, read, rescheduled and deleted.
 type OccurrenceService struct {
 	client *Client
 }
 
+// List returns the occurrences matching the query, optionally limited to a
+// maintenance window and to a time range.
 func (os *OccurrenceService) List(query ListOccurrenceQuery) ([]Occurrence, error) {
-	params := query.toParams()
-	req, err := os.client.NewRequest("GET", "/maintenance.occurrences", params)
-	if err != nil {
-		return nil, err
+	if query.From != 0 && query.To != 0 && query.From > query.To {
+		return nil, fmt.Errorf("invalid value for `From`, must not be after `To`")
 	}
 
-	resp, err := os.client.client.Do(req)
+	params := query.toParams()
+	req, err := os.client.NewRequest("GET", "/maintenance.occurrences", params)
 	if err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
 
-	if err := validateResponse(resp); err != nil {
+	m := &listOccurrenceResponse{}
+	if _, err := os.client.doStream(req, m); err != nil {
 		return nil, err
 	}
-
-	bodyBytes, _ := ioutil.ReadAll(resp.Body)
-	bodyString := string(bodyBytes)
-
-	m := &listOccurrenceResponse{}
-	err = json.Unmarshal([]byte(bodyString), &m)
-
-	return m.Occurrences, err
+	return m.Occurrences, nil
 }
 
+// Read returns the occurrence for the given ID.
 func (os *OccurrenceService) Read(id int64) (*Occurrence, error) {
 	req, err := os.client.NewRequest("GET", "/maintenance.occurrences/"+strconv.FormatInt(id, 10), nil)
 	if err != nil {
@@ -72,7 +69,23 @@ func (os *OccurrenceService) Update(id int64, occurrence Occurrence) (*PingdomRe
 	return m, err
 }
 
-// MultiDelete will delete the Occurrence for the given ID.
+// Reschedule moves the occurrence for the given ID to a new window, leaving
+// the other occurrences of its maintenance and the recurrence rule untouched.
+// It fails without changing anything when the occurrence does not exist.
+func (os *OccurrenceService) Reschedule(id int64, from, to time.Time) (*PingdomResponse, error) {
+	occurrence := Occurrence{From: from.Unix(), To: to.Unix()}
+	if err := occurrence.Valid(); err != nil {
+		return nil, err
+	}
+
+	if _, err := os.Read(id); err != nil {
+		return nil, err
+	}
+	return os.Update(id, occurrence)
+}
+
+// MultiDelete will delete the Occurrences for the given IDs in a single
+// request.
 func (os *OccurrenceService) MultiDelete(ids []int64) (*PingdomResponse, error) {
 	if len(ids) == 0 {
 		return nil, fmt.Errorf("empty id list for multiple occurrence delete")
@@ -2,10 +2,13 @@ package pingdom
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/stretchr/testify/assert"
 	"net/http"
+	"net/url"
 	"testing"
+	"time"
 )
 
 func TestOccurrenceServiceList(t *testing.T) {
@@ -46,6 +49,32 @@ func TestOccurrenceServiceList(t *testing.T) {
 	assert.Equal(t, want.Occurrences, occurrences, "Occurrence.List() should return correct result")
 }
 
+func TestOccurrenceServiceListFiltered(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/maintenance.occurrences", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		assert.Equal(t, url.Values{
+			"maintenanceid": {"224724"},
+			"from":          {"1617600000"},
+			"to":            {"1617800000"},
+		}, r.URL.Query())
+		_, _ = fmt.Fprint(w, `{"occurrences": [{"id": 6110986, "maintenanceid": 224724, "from": 1617699622, "to": 1617703222}]}`)
+	})
+
+	occurrences, err := client.Occurrences.List(ListOccurrenceQuery{
+		MaintenanceId: 224724,
+		From:          1617600000,
+		To:            1617800000,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []Occurrence{{Id: 6110986, MaintenanceId: 224724, From: 1617699622, To: 1617703222}}, occurrences)
+
+	_, err = client.Occurrences.List(ListOccurrenceQuery{From: 1617800000, To: 1617600000})
+	assert.Error(t, err)
+}
+
 func TestOccurrenceServiceRead(t *testing.T) {
 	setup()
 	defer teardown()
@@ -105,6 +134,39 @@ func TestOccurrenceServiceUpdate(t *testing.T) {
 	assert.Equal(t, want, msg, "Occurrence.Update() should return correct result")
 }
 
+func TestOccurrenceServiceReschedule(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/maintenance.occurrences/6110986", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "GET" {
+			_, _ = fmt.Fprint(w, `{"occurrence": {"id": 6110986, "maintenanceid": 224724, "from": 1617699622, "to": 1617703222}}`)
+			return
+		}
+		testMethod(t, r, "PUT")
+		m := map[string]int64{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&m))
+		assert.Equal(t, map[string]int64{"from": 1617786022, "to": 1617789622}, m)
+		_, _ = fmt.Fprint(w, `{"message": "Occurrence updated"}`)
+	})
+	mux.HandleFunc("/maintenance.occurrences/404", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = fmt.Fprint(w, `{"error":{"statuscode":404,"statusdesc":"Not Found","errormessage":"Occurrence not found"}}`)
+	})
+
+	from := time.Unix(1617786022, 0)
+	msg, err := client.Occurrences.Reschedule(6110986, from, from.Add(time.Hour))
+	assert.NoError(t, err)
+	assert.Equal(t, &PingdomResponse{Message: "Occurrence updated"}, msg)
+
+	_, err = client.Occurrences.Reschedule(404, from, from.Add(time.Hour))
+	assert.True(t, errors.Is(err, ErrNotFound))
+
+	_, err = client.Occurrences.Reschedule(6110986, from, from.Add(-time.Hour))
+	assert.Error(t, err)
+}
+
 func TestOccurrenceServiceDelete(t *testing.T) {
 	setup()
 	defer teardown()
@@ -6,6 +6,7 @@ import (
 	"strconv"
 )
 
+// Occurrence is a single window of a maintenance, from and to Unix times.
 type Occurrence struct {
 	Id            int64  `json:"id"`
 	MaintenanceId int64  `json:"maintenanceid"`
@@ -15,6 +16,8 @@ type Occurrence struct {
 	DurationUnit  string `json:"durationunit"`
 }
 
+// ListOccurrenceQuery filters the occurrences listed by OccurrenceService.
+// Zero fields are not filtered on.
 type ListOccurrenceQuery struct {
 	From          int64 `json:"from"`
 	To            int64 `json:"to"`
@@ -52,6 +55,10 @@ func (o *Occurrence) Valid() error {
 		return fmt.Errorf("Invalid value for `To`.  Must contain time")
 	}
 
+	if o.From >= o.To {
+		return fmt.Errorf("Invalid value for `From`.  Must be before `To`")
+	}
+
 	return nil
 }
 
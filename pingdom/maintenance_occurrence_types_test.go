@@ -28,6 +28,13 @@ func TestOccurrenceValid(t *testing.T) {
 	o.From = 1
 	o.To = 0
 	assert.Error(t, o.Valid())
+
+	o.From = 2
+	o.To = 1
+	assert.Error(t, o.Valid())
+
+	o.To = 3
+	assert.NoError(t, o.Valid())
 }
 
 func TestRenderForRESTAPIJSON(t *testing.T) {
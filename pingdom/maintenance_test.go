@@ -3,7 +3,9 @@ package pingdom
 import (
 	"fmt"
 	"net/http"
+	"net/url"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -87,6 +89,37 @@ func TestMaintenanceServiceCreate(t *testing.T) {
 	assert.Equal(t, want, maintenances, "Maintenances.Create() should return correct result")
 }
 
+func TestMaintenanceServiceCreateImmediate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.now = func() time.Time {
+		return time.Date(2019, 7, 17, 20, 0, 0, 600000000, time.FixedZone("CEST", 2*60*60))
+	}
+
+	mux.HandleFunc("/maintenance", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		assert.Equal(t, url.Values{
+			"description": {"Emergency"},
+			"from":        {"1563386400"},
+			"to":          {"1563388201"},
+			"uptimeids":   {"1,2"},
+		}, r.URL.Query())
+		fmt.Fprint(w, `{"maintenance":{"id":85975}}`)
+	})
+
+	maintenance, err := client.Maintenances.CreateImmediate([]int{1, 2}, 30*time.Minute+500*time.Millisecond, "Emergency")
+	assert.NoError(t, err)
+	assert.Equal(t, &MaintenanceResponse{ID: 85975}, maintenance)
+
+	_, err = client.Maintenances.CreateImmediate(nil, time.Hour, "Emergency")
+	assert.Error(t, err)
+	_, err = client.Maintenances.CreateImmediate([]int{1}, 0, "Emergency")
+	assert.Error(t, err)
+	_, err = client.Maintenances.CreateImmediate([]int{1}, time.Hour, "")
+	assert.Error(t, err)
+}
+
 func TestMaintenanceServiceRead(t *testing.T) {
 	setup()
 	defer teardown()
@@ -129,6 +162,45 @@ func TestMaintenanceServiceRead(t *testing.T) {
 	assert.Equal(t, want, maintenance, "Maintenances.Read() should return correct result")
 }
 
+func TestMaintenanceServiceReadWithOptions(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/maintenance/456", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{
+			"maintenance": {
+				"id": 456,
+				"description": "Particular maintenance window",
+				"checks": {
+					"uptime": [506206, 222],
+					"tms": [123]
+				}
+			}
+		}`)
+	})
+	mux.HandleFunc("/checks", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"checks": [{"id": 506206, "name": "Web"}, {"id": 1, "name": "Other"}]}`)
+	})
+	mux.HandleFunc("/tms/check", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"checks": [{"id": 123, "name": "Checkout"}]}`)
+	})
+
+	want := MaintenanceCheckResponse{
+		Uptime:      []int{506206, 222},
+		Tms:         []int{123},
+		UptimeNames: map[int]string{506206: "Web"},
+		TmsNames:    map[int]string{123: "Checkout"},
+	}
+
+	maintenance, err := client.Maintenances.ReadWithOptions(456, MaintenanceReadOptions{Resolve: true})
+	assert.NoError(t, err)
+	assert.Equal(t, want, maintenance.Checks)
+
+	maintenance, err = client.Maintenances.ReadWithOptions(456, MaintenanceReadOptions{})
+	assert.NoError(t, err)
+	assert.Nil(t, maintenance.Checks.UptimeNames)
+}
+
 func TestMaintenanceServiceUpdate(t *testing.T) {
 	setup()
 	defer teardown()
@@ -164,3 +236,46 @@ func TestMaintenanceServiceDelete(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, want, msg, "Maintenances.Delete() should return correct result")
 }
+
+func TestMaintenanceServiceUpdateChecks(t *testing.T) {
+	t.Run("replaces and clears checks", func(t *testing.T) {
+		setup()
+		defer teardown()
+
+		mux.HandleFunc("/maintenance/12345", func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, "PUT")
+			assert.Equal(t, url.Values{"uptimeids": {"1,2"}, "tmsids": {""}}, r.URL.Query())
+			fmt.Fprint(w, `{"message":"Maintenance window successfully modified!"}`)
+		})
+
+		msg, err := client.Maintenances.UpdateChecks(12345, MaintenanceChecks{
+			UptimeIDs: []int{1, 2},
+			TmsIDs:    []int{},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, &PingdomResponse{Message: "Maintenance window successfully modified!"}, msg)
+	})
+
+	t.Run("appends checks", func(t *testing.T) {
+		setup()
+		defer teardown()
+
+		mux.HandleFunc("/maintenance/12345", func(w http.ResponseWriter, r *http.Request) {
+			switch r.Method {
+			case "GET":
+				fmt.Fprint(w, `{"maintenance":{"id":12345,"checks":{"uptime":[1,2],"tms":[7]}}}`)
+			case "PUT":
+				assert.Equal(t, url.Values{"uptimeids": {"1,2,3"}}, r.URL.Query())
+				fmt.Fprint(w, `{"message":"Maintenance window successfully modified!"}`)
+			default:
+				t.Errorf("unexpected method %v", r.Method)
+			}
+		})
+
+		_, err := client.Maintenances.UpdateChecks(12345, MaintenanceChecks{
+			UptimeIDs: []int{2, 3},
+			Append:    true,
+		})
+		assert.NoError(t, err)
+	})
+}
@@ -22,6 +22,23 @@ type MaintenanceWindowDelete struct {
 	MaintenanceIDs string `json:"maintenanceids"`
 }
 
+// MaintenanceChecks is the set of checks to associate with an existing
+// maintenance window.  A nil list leaves the association of that kind of
+// check untouched, while an empty non-nil list clears it.
+type MaintenanceChecks struct {
+	UptimeIDs []int
+	TmsIDs    []int
+	// Append adds the given checks to those already associated with the
+	// window instead of replacing them.
+	Append bool
+}
+
+// MaintenanceReadOptions holds the options of MaintenanceService.ReadWithOptions.
+type MaintenanceReadOptions struct {
+	// Resolve looks up the names of the checks covered by the window.
+	Resolve bool
+}
+
 // PutParams returns a map of parameters for an MaintenanceWindow that can be sent along.
 func (ck *MaintenanceWindow) PutParams() map[string]string {
 	m := map[string]string{
@@ -104,3 +121,56 @@ func (ck *MaintenanceWindowDelete) ValidDelete() error {
 
 	return nil
 }
+
+// PutParams returns a map of parameters for a MaintenanceChecks that can be
+// sent along with an HTTP PUT request.  Empty lists are kept, as an empty
+// value clears the association.
+func (mc *MaintenanceChecks) PutParams() map[string]string {
+	m := map[string]string{}
+
+	if mc.UptimeIDs != nil {
+		m["uptimeids"] = intListToCDString(mc.UptimeIDs)
+	}
+
+	if mc.TmsIDs != nil {
+		m["tmsids"] = intListToCDString(mc.TmsIDs)
+	}
+
+	return m
+}
+
+// Valid determines whether the MaintenanceChecks contains valid fields.
+func (mc *MaintenanceChecks) Valid() error {
+	if mc.UptimeIDs == nil && mc.TmsIDs == nil {
+		return fmt.Errorf("Invalid value for `UptimeIDs` and `TmsIDs`.  At least one must be set")
+	}
+
+	return nil
+}
+
+// merge returns a copy of mc where the lists are appended to the given
+// current ones, without duplicates.
+func (mc *MaintenanceChecks) merge(current MaintenanceCheckResponse) *MaintenanceChecks {
+	merged := &MaintenanceChecks{}
+	if mc.UptimeIDs != nil {
+		merged.UptimeIDs = mergeIntLists(current.Uptime, mc.UptimeIDs)
+	}
+	if mc.TmsIDs != nil {
+		merged.TmsIDs = mergeIntLists(current.Tms, mc.TmsIDs)
+	}
+	return merged
+}
+
+func mergeIntLists(a, b []int) []int {
+	merged := make([]int, 0, len(a)+len(b))
+	seen := map[int]bool{}
+	for _, l := range [][]int{a, b} {
+		for _, i := range l {
+			if !seen[i] {
+				seen[i] = true
+				merged = append(merged, i)
+			}
+		}
+	}
+	return merged
+}
@@ -70,3 +70,33 @@ func TestMaintenanceNotValid(t *testing.T) {
 
 	assert.NotEqual(t, nil, params, "Maintenance.Valid() should return not nil if not valid")
 }
+
+func TestMaintenanceChecksPutParams(t *testing.T) {
+	tests := []struct {
+		name       string
+		giveChecks MaintenanceChecks
+		wantParams map[string]string
+	}{
+		{
+			name:       "replaces uptime checks only",
+			giveChecks: MaintenanceChecks{UptimeIDs: []int{1, 2}},
+			wantParams: map[string]string{"uptimeids": "1,2"},
+		},
+		{
+			name:       "clears tms checks",
+			giveChecks: MaintenanceChecks{UptimeIDs: []int{1}, TmsIDs: []int{}},
+			wantParams: map[string]string{"uptimeids": "1", "tmsids": ""},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantParams, tt.giveChecks.PutParams())
+		})
+	}
+}
+
+func TestMaintenanceChecksValid(t *testing.T) {
+	assert.Error(t, (&MaintenanceChecks{}).Valid())
+	assert.NoError(t, (&MaintenanceChecks{TmsIDs: []int{}}).Valid())
+}
@@ -0,0 +1,169 @@
+package pingdom
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// Option configures a Client created with NewClient.
+type Option func(*Client) error
+
+// WithHTTPClient makes the client send requests with the given HTTP client
+// instead of http.DefaultClient.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) error {
+		c.client = httpClient
+		return nil
+	}
+}
+
+// WithBaseURL overrides the Pingdom API base URL.
+func WithBaseURL(baseURL string) Option {
+	return func(c *Client) error {
+		u, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.BaseURL = u
+		return nil
+	}
+}
+
+// WithUserAgent overrides the User-Agent sent with every request.
+func WithUserAgent(userAgent string) Option {
+	return func(c *Client) error {
+		c.UserAgent = userAgent
+		return nil
+	}
+}
+
+// WithAccountEmail makes the client act on behalf of the sub-account with the
+// given email, for multi-user accounts.  It is sent as the Account-Email
+// header of every request.
+func WithAccountEmail(email string) Option {
+	return func(c *Client) error {
+		c.AccountEmail = email
+		return nil
+	}
+}
+
+// WithRetry makes the client retry failed requests according to the given
+// policy.
+func WithRetry(policy RetryPolicy) Option {
+	return func(c *Client) error {
+		c.retry = policy
+		return nil
+	}
+}
+
+// WithRateLimit caps the number of requests per second sent by the client,
+// shared by all its services and safe for concurrent use.  Up to burst
+// requests, at least one, may be sent at once.  Requests wait for their turn
+// until their context is done.  Retries count as requests.
+func WithRateLimit(rps float64, burst int) Option {
+	return func(c *Client) error {
+		if rps <= 0 {
+			return fmt.Errorf("invalid rate limit %v, must be positive", rps)
+		}
+		c.limiter = newRateLimiter(rps, burst)
+		return nil
+	}
+}
+
+// WithDryRun makes the client return a *DryRunError describing every request
+// that would modify the account, such as creating, updating or deleting a
+// check, instead of sending it.  Reads are sent as usual.
+func WithDryRun() Option {
+	return func(c *Client) error {
+		c.dryRun = true
+		return nil
+	}
+}
+
+// WithETagCache makes the client cache the probe lists and reference data
+// responses carrying an ETag, and revalidate them with If-None-Match.  Other
+// responses, such as check results, are not cached.
+// When Pingdom answers 304 Not Modified, the cached body is returned as if it
+// had been sent again.  Copies of the client share the cache.
+func WithETagCache() Option {
+	return func(c *Client) error {
+		c.etags = newETagCache()
+		return nil
+	}
+}
+
+// WithTimeout sets a timeout on the HTTP client built by NewClient.  The
+// timeout applies to each request, including reading the response body.  It
+// has no effect when a custom HTTP client is given with WithHTTPClient.
+func WithTimeout(timeout time.Duration) Option {
+	return func(c *Client) error {
+		c.timeout = timeout
+		return nil
+	}
+}
+
+// WithRequestHook sets the hook called with a copy of every request.
+func WithRequestHook(fn func(*http.Request)) Option {
+	return func(c *Client) error {
+		c.OnRequest = fn
+		return nil
+	}
+}
+
+// WithResponseHook sets the hook called with a copy of every response.
+func WithResponseHook(fn func(*http.Response)) Option {
+	return func(c *Client) error {
+		c.OnResponse = fn
+		return nil
+	}
+}
+
+// WithLogger dumps every request and response to w, unless a request or
+// response hook is set.
+func WithLogger(w io.Writer) Option {
+	return func(c *Client) error {
+		c.logger = w
+		return nil
+	}
+}
+
+// WithEventHandler sets the handler receiving retry and rate-limit events.
+func WithEventHandler(h EventHandler) Option {
+	return func(c *Client) error {
+		c.EventHandler = h
+		return nil
+	}
+}
+
+// WithClock makes the client use the given function to get the current time,
+// e.g. to compute default time windows.  This is mostly useful in tests.
+func WithClock(now func() time.Time) Option {
+	return func(c *Client) error {
+		c.now = now
+		return nil
+	}
+}
+
+// WithDefaultWindow sets the time window, ending now, used by summary and
+// results requests when `from` or `to` is not given.  It defaults to 24
+// hours; zero leaves them unset so that the API defaults apply.
+func WithDefaultWindow(window time.Duration) Option {
+	return func(c *Client) error {
+		c.window = window
+		return nil
+	}
+}
+
+// WithPreloadReferences makes NewClient fetch the reference data of the
+// account, used to validate the SMS country codes of contacts and, through
+// ValidRegion, ValidTimezone, ValidDateTimeFormat and ValidNumberFormat,
+// account settings locally.  A failure to fetch it is not fatal.
+func WithPreloadReferences() Option {
+	return func(c *Client) error {
+		c.preloadRefs = true
+		return nil
+	}
+}
@@ -0,0 +1,51 @@
+package pingdom
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewClient(t *testing.T) {
+	httpClient := &http.Client{}
+	c, err := NewClient("key",
+		WithHTTPClient(httpClient),
+		WithBaseURL("https://example.com/api"),
+		WithUserAgent("my-app"),
+		WithRetry(RetryPolicy{MaxRetries: 3}),
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, "key", c.APIToken)
+	assert.Equal(t, httpClient, c.client)
+	assert.Equal(t, "https://example.com/api", c.BaseURL.String())
+	assert.Equal(t, "my-app", c.UserAgent)
+	assert.Equal(t, RetryPolicy{MaxRetries: 3}, c.retry)
+	assert.NotNil(t, c.Checks)
+}
+
+func TestNewClientDefaults(t *testing.T) {
+	c, err := NewClient("key")
+	assert.NoError(t, err)
+	assert.Equal(t, http.DefaultClient, c.client)
+	assert.Equal(t, defaultBaseURL, c.BaseURL.String())
+	assert.Equal(t, defaultUserAgent, c.UserAgent)
+}
+
+func TestNewClientWithTimeout(t *testing.T) {
+	c, err := NewClient("key", WithTimeout(5*time.Second))
+	assert.NoError(t, err)
+	assert.Equal(t, 5*time.Second, c.client.Timeout)
+
+	httpClient := &http.Client{}
+	c, err = NewClient("key", WithTimeout(5*time.Second), WithHTTPClient(httpClient))
+	assert.NoError(t, err)
+	assert.Equal(t, httpClient, c.client)
+	assert.Equal(t, time.Duration(0), c.client.Timeout)
+}
+
+func TestNewClientWithInvalidBaseURL(t *testing.T) {
+	_, err := NewClient("key", WithBaseURL(":"))
+	assert.Error(t, err)
+}
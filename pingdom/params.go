@@ -0,0 +1,21 @@
+package pingdom
+
+// mergeParams returns a new map holding the params of all the given maps.
+// When a param is in several maps, the value of the last map wins, so typed
+// request params can be combined with raw overrides:
+//
+//	mergeParams(query.GetParams(), overrides)
+func mergeParams(params ...map[string]string) map[string]string {
+	size := 0
+	for _, p := range params {
+		size += len(p)
+	}
+
+	m := make(map[string]string, size)
+	for _, p := range params {
+		for k, v := range p {
+			m[k] = v
+		}
+	}
+	return m
+}
@@ -0,0 +1,24 @@
+package pingdom
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMergeParams(t *testing.T) {
+	typed := map[string]string{"limit": "10", "onlyactive": "true"}
+	overrides := map[string]string{"limit": "20", "new_param": "x"}
+
+	assert.Equal(t, map[string]string{
+		"limit":      "20",
+		"onlyactive": "true",
+		"new_param":  "x",
+	}, mergeParams(typed, overrides))
+
+	// The given maps are left untouched.
+	assert.Equal(t, map[string]string{"limit": "10", "onlyactive": "true"}, typed)
+
+	assert.Equal(t, map[string]string{}, mergeParams())
+	assert.Equal(t, map[string]string{"limit": "10", "onlyactive": "true"}, mergeParams(typed, nil))
+}
@@ -1,30 +1,59 @@
 package pingdom
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
 	"net/url"
 	"os"
 	"strings"
+	"time"
 )
 
 const (
-	defaultBaseURL = "https://api.pingdom.com/api/3.1"
+	defaultBaseURL   = "https://api.pingdom.com/api/3.1"
+	libraryVersion   = "1.4.3"
+	defaultUserAgent = "go-pingdom/" + libraryVersion
 )
 
 // Client represents a client to the Pingdom API.
 type Client struct {
 	APIToken     string
 	BaseURL      *url.URL
+	UserAgent    string
+	AccountEmail string
+	OnRequest    func(*http.Request)
+	OnResponse   func(*http.Response)
+	EventHandler EventHandler
 	client       *http.Client
+	timeout      time.Duration
+	now          func() time.Time
+	window       time.Duration
+	references   *References
+	preloadRefs  bool
+	retry        RetryPolicy
+	limiter      *rateLimiter
+	dryRun       bool
+	etags        *etagCache
+	ctx          context.Context
+	serverClock  *serverClock
+	logger       io.Writer
+	Actions      *ActionService
 	Checks       *CheckService
 	Contacts     *ContactService
+	Credits      *CreditService
 	Maintenances *MaintenanceService
 	Occurrences  *OccurrenceService
 	Probes       *ProbeService
+	References   *ReferenceService
+	SingleChecks *SingleCheckService
+	Summaries    *SummaryService
 	Teams        *TeamService
+	Traceroutes  *TracerouteService
 	TMSCheck     *TMSCheckService
 }
 
@@ -33,55 +62,230 @@ type ClientConfig struct {
 	APIToken   string
 	BaseURL    string
 	HTTPClient *http.Client
+	// Timeout limits the time of each request, including reading the
+	// response body.  It is only used when HTTPClient is not set.
+	Timeout time.Duration
+	// PreloadReferences fetches the reference data of the account when the
+	// client is created, to validate the SMS country codes of contacts and
+	// the region, time zone and format IDs of account settings locally.  A
+	// failure to fetch it is not fatal.
+	PreloadReferences bool
+	// UserAgent is sent with every request. Defaults to "go-pingdom/<version>".
+	UserAgent string
+	// AccountEmail, when set, is sent as the Account-Email header of every
+	// request, so that a multi-user account acts on behalf of the sub-account
+	// with that email.
+	AccountEmail string
+	// OnRequest and OnResponse are called for every request made by the
+	// client. They receive copies, so reading the body is safe, and the
+	// Authorization header is redacted.
+	OnRequest  func(*http.Request)
+	OnResponse func(*http.Response)
+	// RateLimit, when set, caps the number of requests per second sent by
+	// the client, across all its services.  Up to RateLimitBurst requests,
+	// at least one, may be sent at once.
+	RateLimit      float64
+	RateLimitBurst int
+	// DryRun makes the client return a *DryRunError describing every
+	// request that would modify the account instead of sending it.  Reads
+	// are sent as usual.
+	DryRun bool
+	// ETagCache makes the client cache probe lists and reference data
+	// carrying an ETag and revalidate them with If-None-Match, serving the
+	// cached body when Pingdom answers 304 Not Modified.
+	ETagCache bool
+	// Logger, when set, receives a dump of every request and response. It is
+	// ignored for whichever of OnRequest and OnResponse is set.
+	Logger io.Writer
 }
 
 // NewClientWithConfig returns a Pingdom client.
 func NewClientWithConfig(config ClientConfig) (*Client, error) {
-	var baseURL *url.URL
-	var err error
+	var opts []Option
 	if config.BaseURL != "" {
-		baseURL, err = url.Parse(config.BaseURL)
-	} else {
-		baseURL, err = url.Parse(defaultBaseURL)
+		opts = append(opts, WithBaseURL(config.BaseURL))
 	}
+	if config.HTTPClient != nil {
+		opts = append(opts, WithHTTPClient(config.HTTPClient))
+	}
+	if config.Timeout != 0 {
+		opts = append(opts, WithTimeout(config.Timeout))
+	}
+	if config.UserAgent != "" {
+		opts = append(opts, WithUserAgent(config.UserAgent))
+	}
+	if config.AccountEmail != "" {
+		opts = append(opts, WithAccountEmail(config.AccountEmail))
+	}
+	if config.OnRequest != nil {
+		opts = append(opts, WithRequestHook(config.OnRequest))
+	}
+	if config.OnResponse != nil {
+		opts = append(opts, WithResponseHook(config.OnResponse))
+	}
+	if config.RateLimit != 0 {
+		opts = append(opts, WithRateLimit(config.RateLimit, config.RateLimitBurst))
+	}
+	if config.DryRun {
+		opts = append(opts, WithDryRun())
+	}
+	if config.ETagCache {
+		opts = append(opts, WithETagCache())
+	}
+	if config.Logger != nil {
+		opts = append(opts, WithLogger(config.Logger))
+	}
+	if config.PreloadReferences {
+		opts = append(opts, WithPreloadReferences())
+	}
+
+	return NewClient(config.APIToken, opts...)
+}
+
+// NewClient returns a Pingdom client authenticating with the given API token,
+// configured by the given options.  If the token is empty, it is read from
+// the PINGDOM_API_TOKEN environment variable.
+func NewClient(token string, opts ...Option) (*Client, error) {
+	baseURL, err := url.Parse(defaultBaseURL)
 	if err != nil {
 		return nil, err
 	}
 
 	c := &Client{
-		BaseURL: baseURL,
+		APIToken:    token,
+		BaseURL:     baseURL,
+		UserAgent:   defaultUserAgent,
+		now:         time.Now,
+		window:      defaultWindow,
+		serverClock: &serverClock{},
 	}
 
-	if config.APIToken == "" {
+	if c.APIToken == "" {
 		if envAPIToken, set := os.LookupEnv("PINGDOM_API_TOKEN"); set {
 			c.APIToken = envAPIToken
 		}
-	} else {
-		c.APIToken = config.APIToken
 	}
 
-	if config.HTTPClient != nil {
-		c.client = config.HTTPClient
-	} else {
-		c.client = http.DefaultClient
-	}
-
-	c.Checks = &CheckService{client: c}
-	c.Contacts = &ContactService{client: c}
-	c.Maintenances = &MaintenanceService{client: c}
-	c.Occurrences = &OccurrenceService{client: c}
-	c.Probes = &ProbeService{client: c}
-	c.Teams = &TeamService{client: c}
-	c.TMSCheck = &TMSCheckService{client: c}
+	for _, opt := range opts {
+		if err := opt(c); err != nil {
+			return nil, err
+		}
+	}
+
+	if c.client == nil {
+		if c.timeout != 0 {
+			c.client = &http.Client{Timeout: c.timeout}
+		} else {
+			c.client = http.DefaultClient
+		}
+	}
+
+	if c.logger != nil {
+		if c.OnRequest == nil {
+			c.OnRequest = logRequest(c.logger)
+		}
+		if c.OnResponse == nil {
+			c.OnResponse = logResponse(c.logger)
+		}
+	}
+
+	c.initServices()
+
+	if c.preloadRefs {
+		// Failing to load the references is not fatal: the values they
+		// validate are then left to the API.
+		if refs, err := c.References.Get(); err == nil {
+			c.references = refs
+		}
+	}
 	return c, nil
 }
 
+// initServices points the services of the client to it.
+func (pc *Client) initServices() {
+	pc.Actions = &ActionService{client: pc}
+	pc.Checks = &CheckService{client: pc}
+	pc.Contacts = &ContactService{client: pc}
+	pc.Credits = &CreditService{client: pc}
+	pc.Maintenances = &MaintenanceService{client: pc}
+	pc.Occurrences = &OccurrenceService{client: pc}
+	pc.Probes = &ProbeService{client: pc}
+	pc.References = &ReferenceService{client: pc}
+	pc.SingleChecks = &SingleCheckService{client: pc}
+	pc.Summaries = &SummaryService{client: pc}
+	pc.Teams = &TeamService{client: pc}
+	pc.Traceroutes = &TracerouteService{client: pc}
+	pc.TMSCheck = &TMSCheckService{client: pc}
+}
+
+// clone returns a shallow copy of the client with its own services.  The copy
+// shares the HTTP client, rate limiter, ETag cache and references of pc.
+func (pc *Client) clone() *Client {
+	c := *pc
+	c.initServices()
+	return &c
+}
+
+// Clone returns a copy of the client, e.g. to give each subsystem of an
+// application its own UserAgent or AccountEmail, which can be set on the copy
+// without affecting pc.  The copy shares the HTTP client and its connections,
+// the rate limiter and the ETag cache of pc, so throttling applies to pc and
+// all its copies together.
+func (pc *Client) Clone() *Client {
+	return pc.clone()
+}
+
+// CloneWithBaseURL returns a copy of the client sending its requests to the
+// given base URL, e.g. a mock server or another region.  The copy shares the
+// HTTP client of pc, so connections are reused.  Prefer this over changing
+// BaseURL, which is not safe while requests are in flight.
+func (pc *Client) CloneWithBaseURL(baseURL string) (*Client, error) {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, err
+	}
+
+	c := pc.clone()
+	c.BaseURL = u
+	return c, nil
+}
+
+// WithContext returns a copy of the client whose requests are bound to ctx,
+// so that their deadline and cancellation apply without passing ctx to every
+// call.  The copy shares the HTTP client and credentials of pc.
+func (pc *Client) WithContext(ctx context.Context) *Client {
+	if ctx == nil {
+		panic("pingdom: nil context")
+	}
+	c := pc.clone()
+	c.ctx = ctx
+	return c
+}
+
+// requestContext returns the context requests are bound to.
+func (pc *Client) requestContext() context.Context {
+	if pc.ctx == nil {
+		return context.Background()
+	}
+	return pc.ctx
+}
+
+// Ping makes a cheap request to check that the client can reach Pingdom with
+// valid credentials, e.g. before a long provisioning job.  It returns nil on
+// success, and an error matching ErrUnauthorized or ErrForbidden with
+// errors.Is when the credentials are rejected.
+func (pc *Client) Ping() error {
+	_, err := pc.Credits.Get()
+	return err
+}
+
 // NewRequest makes a new HTTP Request.  The method param should be an HTTP method in
 // all caps such as GET, POST, PUT, DELETE.  The rsc param should correspond with
 // a restful resource.  Params can be passed in as a map of strings
 // Usually users of the client can use one of the convenience methods such as
 // ListChecks, etc but this method is provided to allow for making other
-// API calls that might not be built in.
+// API calls that might not be built in.  The params are encoded in the query
+// string sorted by key, so the resulting URL is stable.
 func (pc *Client) NewRequest(method string, rsc string, params map[string]string) (*http.Request, error) {
 	baseURL, err := url.Parse(pc.BaseURL.String() + rsc)
 	if err != nil {
@@ -96,11 +300,18 @@ func (pc *Client) NewRequest(method string, rsc string, params map[string]string
 		baseURL.RawQuery = ps.Encode()
 	}
 
-	req, err := http.NewRequest(method, baseURL.String(), nil)
-	req.Header.Add("Authorization", "Bearer "+pc.APIToken)
-	return req, err
+	req, err := http.NewRequestWithContext(pc.requestContext(), method, baseURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	pc.addAuthHeaders(req)
+	return req, nil
 }
 
+// NewRequestMultiParamValue is like NewRequest but allows several values per
+// param.  The params are encoded in the query string sorted by key, and the
+// values of a param in the order they are given, so the resulting URL is
+// stable.
 func (pc *Client) NewRequestMultiParamValue(method string, rsc string, params map[string][]string) (*http.Request, error) {
 	baseURL, err := url.Parse(pc.BaseURL.String() + rsc)
 	if err != nil {
@@ -117,9 +328,12 @@ func (pc *Client) NewRequestMultiParamValue(method string, rsc string, params ma
 		baseURL.RawQuery = ps.Encode()
 	}
 
-	req, err := http.NewRequest(method, baseURL.String(), nil)
-	req.Header.Add("Authorization", "Bearer "+pc.APIToken)
-	return req, err
+	req, err := http.NewRequestWithContext(pc.requestContext(), method, baseURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	pc.addAuthHeaders(req)
+	return req, nil
 }
 
 // NewJSONRequest makes a new HTTP Request.  The method param should be an HTTP method in
@@ -133,17 +347,64 @@ func (pc *Client) NewJSONRequest(method string, rsc string, params string) (*htt
 
 	reqBody := strings.NewReader(params)
 
-	req, err := http.NewRequest(method, baseURL.String(), reqBody)
-	req.Header.Add("Authorization", "Bearer "+pc.APIToken)
-	req.Header.Add("Content-Type", "application/json")
-	return req, err
+	req, err := http.NewRequestWithContext(pc.requestContext(), method, baseURL.String(), reqBody)
+	if err != nil {
+		return nil, err
+	}
+	pc.addAuthHeaders(req)
+	req.Header.Set("Content-Type", "application/json")
+	return req, nil
+}
+
+// NewJSONRequestFromValue is like NewJSONRequest but marshals body to JSON
+// itself, returning an error when it cannot be marshaled.
+func (pc *Client) NewJSONRequestFromValue(method string, rsc string, body interface{}) (*http.Request, error) {
+	b, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	return pc.NewJSONRequest(method, rsc, string(b))
+}
+
+// NewFormRequest makes a new HTTP Request with the params form-encoded in the
+// body rather than in the query string, for any method including DELETE.  This
+// suits endpoints taking more params than fit in a URL.  The params are
+// encoded sorted by key, so the resulting body is stable.
+func (pc *Client) NewFormRequest(method string, rsc string, params url.Values) (*http.Request, error) {
+	baseURL, err := url.Parse(pc.BaseURL.String() + rsc)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(pc.requestContext(), method, baseURL.String(), strings.NewReader(params.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	pc.addAuthHeaders(req)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	return req, nil
+}
+
+// addAuthHeaders attaches the credentials, the sub-account if any and the
+// User-Agent to a request, and asks for a gzip-encoded response, which send
+// decompresses.
+func (pc *Client) addAuthHeaders(req *http.Request) {
+	req.Header.Set("Authorization", "Bearer "+pc.APIToken)
+	req.Header.Set("Accept-Encoding", "gzip")
+	if pc.AccountEmail != "" {
+		req.Header.Set("Account-Email", pc.AccountEmail)
+	}
+	if pc.UserAgent != "" {
+		req.Header.Set("User-Agent", pc.UserAgent)
+	}
 }
 
 // Do makes an HTTP request and will unmarshal the JSON response in to the
 // passed in interface.  If the HTTP response is outside of the 2xx range the
-// response will be returned along with the error.
+// response will be returned along with the error.  Pass a *RawResponse as v
+// to also get hold of the raw response body.
 func (pc *Client) Do(req *http.Request, v interface{}) (*http.Response, error) {
-	resp, err := pc.client.Do(req)
+	resp, err := pc.send(req)
 	if err != nil {
 		return nil, err
 	}
@@ -157,20 +418,148 @@ func (pc *Client) Do(req *http.Request, v interface{}) (*http.Response, error) {
 	return resp, err
 }
 
+// doStream makes an HTTP request like Do, but decodes the JSON response into
+// v as it is read, which saves memory on large list and results responses.
+// The saving is lost when an OnResponse hook is set or the response is kept
+// by the ETag cache, since both read the whole body first.
+func (pc *Client) doStream(req *http.Request, v interface{}) (*http.Response, error) {
+	resp, err := pc.send(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if err := validateResponse(resp); err != nil {
+		return resp, err
+	}
+
+	err = decodeStream(resp, v)
+	return resp, err
+}
+
+// DoRaw makes an HTTP request and returns the raw response body without
+// decoding it.  Errors are handled as in Do.  Passing a *json.RawMessage to
+// Do works as well when the body is known to be JSON.
+func (pc *Client) DoRaw(req *http.Request) ([]byte, *http.Response, error) {
+	raw := &RawResponse{}
+	resp, err := pc.Do(req, raw)
+	if err != nil {
+		return nil, resp, err
+	}
+	return raw.Body, resp, nil
+}
+
+// send executes the request with the underlying HTTP client, calling the
+// request and response hooks around it.  A gzip-encoded response body is
+// decompressed before the hooks see it, and with an ETag cache a 304 Not
+// Modified response is replaced by the cached one.  In dry-run mode, requests modifying
+// the account are not sent and a *DryRunError is returned instead.
+func (pc *Client) send(req *http.Request) (*http.Response, error) {
+	if pc.dryRun && isMutating(req.Method) {
+		return nil, dryRun(req)
+	}
+
+	if pc.etags != nil {
+		pc.etags.prepare(req)
+	}
+
+	if pc.OnRequest != nil {
+		hookReq, err := copyRequest(req)
+		if err != nil {
+			return nil, err
+		}
+		pc.OnRequest(hookReq)
+	}
+
+	resp, err := pc.doWithRetry(req)
+	if err != nil {
+		return nil, err
+	}
+	decompress(resp)
+	if pc.etags != nil {
+		if err := pc.etags.update(req, resp); err != nil {
+			return nil, err
+		}
+	}
+
+	if pc.OnResponse != nil {
+		hookResp, err := copyResponse(resp)
+		if err != nil {
+			resp.Body.Close()
+			return nil, err
+		}
+		pc.OnResponse(hookResp)
+	}
+	return resp, nil
+}
+
+// RawResponse can be passed to Do in place of the usual target to keep the
+// raw response body around, e.g. to read fields this library does not model
+// yet. When Value is non-nil the body is also decoded into it.
+type RawResponse struct {
+	Value interface{}
+	Body  []byte
+}
+
+// location returns the Location header of a response resolved against the
+// request URL, or an empty string when there is none.
+func location(r *http.Response) string {
+	u, err := r.Location()
+	if err != nil {
+		return ""
+	}
+	return u.String()
+}
+
 func decodeResponse(r *http.Response, v interface{}) error {
 	if v == nil {
 		return fmt.Errorf("nil interface provided to decodeResponse")
 	}
 
 	bodyBytes, _ := ioutil.ReadAll(r.Body)
+	if raw, ok := v.(*RawResponse); ok {
+		raw.Body = bodyBytes
+		if raw.Value == nil {
+			return nil
+		}
+		v = raw.Value
+	}
+
+	if len(bytes.TrimSpace(bodyBytes)) == 0 {
+		return emptyBody(r, v)
+	}
+
 	bodyString := string(bodyBytes)
 	err := json.Unmarshal([]byte(bodyString), &v)
 	return err
 }
 
+// decodeStream decodes the JSON body of a response into v as it is read,
+// rather than reading it whole first like decodeResponse.  This saves memory
+// on large list and results responses.
+func decodeStream(r *http.Response, v interface{}) error {
+	err := json.NewDecoder(r.Body).Decode(v)
+	if err == io.EOF {
+		return emptyBody(r, v)
+	}
+	return err
+}
+
+// emptyBody tells whether a successful response with an empty body is
+// acceptable for the target v, which is left untouched.  It is for a 204 No
+// Content, and for a *PingdomResponse whose message is optional.  Otherwise
+// ErrEmptyResponse is returned, since v would lack the expected resource.
+func emptyBody(r *http.Response, v interface{}) error {
+	if _, ok := v.(*PingdomResponse); ok || r.StatusCode == http.StatusNoContent {
+		return nil
+	}
+	return ErrEmptyResponse
+}
+
 // Takes an HTTP response and determines whether it was successful.
 // Returns nil if the HTTP status code is within the 2xx range.  Returns
-// an error otherwise.
+// a *PingdomError otherwise.  When the body is not a JSON error, the
+// PingdomError carries the beginning of the body as its message.
 func validateResponse(r *http.Response) error {
 	if c := r.StatusCode; 200 <= c && c <= 299 {
 		return nil
@@ -181,8 +570,34 @@ func validateResponse(r *http.Response) error {
 	m := &errorJSONResponse{}
 	err := json.Unmarshal([]byte(bodyString), &m)
 	if err != nil {
-		return err
+		// The body is not JSON, e.g. an HTML page from a proxy.
+		return &PingdomError{
+			StatusCode: r.StatusCode,
+			StatusDesc: http.StatusText(r.StatusCode),
+			Message:    bodySnippet(bodyString),
+		}
+	}
+
+	pe := m.Error
+	if pe == nil {
+		pe = &PingdomError{StatusDesc: http.StatusText(r.StatusCode)}
 	}
+	if pe.StatusCode == 0 {
+		pe.StatusCode = r.StatusCode
+	}
+	return pe
+}
 
-	return m.Error
+// maxBodySnippet is the maximum length of the body kept in the message of
+// errors built from non-JSON responses.
+const maxBodySnippet = 200
+
+// bodySnippet returns the body with its surrounding blanks removed,
+// truncated to maxBodySnippet bytes.
+func bodySnippet(body string) string {
+	body = strings.TrimSpace(body)
+	if len(body) <= maxBodySnippet {
+		return body
+	}
+	return strings.ToValidUTF8(body[:maxBodySnippet], "") + "..."
 }
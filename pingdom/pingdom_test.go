@@ -1,14 +1,20 @@
 package pingdom
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io/ioutil"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"os"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -75,6 +81,134 @@ func TestNewClientWithEnvAPITokenWorks(t *testing.T) {
 	assert.Equal(t, c.APIToken, "envSetAwesome")
 }
 
+func TestNewClientWithConfigTimeout(t *testing.T) {
+	setup()
+	defer teardown()
+
+	done := make(chan struct{})
+	defer close(done)
+	mux.HandleFunc("/checks", func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+		}
+	})
+
+	c, err := NewClientWithConfig(ClientConfig{
+		APIToken: "key",
+		BaseURL:  server.URL,
+		Timeout:  10 * time.Millisecond,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 10*time.Millisecond, c.client.Timeout)
+
+	_, err = c.Checks.List()
+	var netErr net.Error
+	assert.True(t, errors.As(err, &netErr))
+	assert.True(t, netErr.Timeout())
+}
+
+func TestClientClone(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.UserAgent = "billing/1.0"
+	client.limiter = newRateLimiter(100, 1)
+
+	var agents []string
+	mux.HandleFunc("/checks/12345", func(w http.ResponseWriter, r *http.Request) {
+		agents = append(agents, r.Header.Get("User-Agent"))
+		fmt.Fprint(w, `{"check":{"id":12345,"name":"My check"}}`)
+	})
+
+	c := client.Clone()
+	c.UserAgent = "alerting/1.0"
+	assert.True(t, client.client == c.client)
+	assert.True(t, client.limiter == c.limiter)
+	assert.True(t, c.Checks.client == c)
+	assert.Equal(t, "billing/1.0", client.UserAgent)
+
+	_, err := client.Checks.Read(12345)
+	assert.NoError(t, err)
+	_, err = c.Checks.Read(12345)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"billing/1.0", "alerting/1.0"}, agents)
+}
+
+func TestClientCloneWithBaseURL(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mirror := http.NewServeMux()
+	mirrorServer := httptest.NewServer(mirror)
+	defer mirrorServer.Close()
+
+	mux.HandleFunc("/checks/12345", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"check":{"id":12345,"name":"primary"}}`)
+	})
+	mirror.HandleFunc("/checks/12345", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"check":{"id":12345,"name":"mirror"}}`)
+	})
+
+	c, err := client.CloneWithBaseURL(mirrorServer.URL)
+	assert.NoError(t, err)
+	assert.Equal(t, client.client, c.client)
+	assert.Equal(t, client.APIToken, c.APIToken)
+
+	check, err := c.Checks.Read(12345)
+	assert.NoError(t, err)
+	assert.Equal(t, "mirror", check.Name)
+
+	check, err = client.Checks.Read(12345)
+	assert.NoError(t, err)
+	assert.Equal(t, "primary", check.Name)
+
+	_, err = client.CloneWithBaseURL(":/invalid")
+	assert.Error(t, err)
+}
+
+func TestClientWithContext(t *testing.T) {
+	setup()
+	defer teardown()
+
+	release := make(chan struct{})
+	defer close(release)
+	mux.HandleFunc("/checks/12345", func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-release:
+		}
+	})
+	mux.HandleFunc("/checks/23456", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"check":{"id":23456,"name":"fast"}}`)
+	})
+
+	type key struct{}
+	ctx, cancel := context.WithCancel(context.WithValue(context.Background(), key{}, "request-scoped"))
+	bound := client.WithContext(ctx)
+	assert.Equal(t, client.client, bound.client)
+	assert.Equal(t, client.APIToken, bound.APIToken)
+
+	req, err := bound.NewRequest("GET", "/checks", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "request-scoped", req.Context().Value(key{}))
+
+	check, err := bound.Checks.Read(23456)
+	assert.NoError(t, err)
+	assert.Equal(t, "fast", check.Name)
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		cancel()
+	}()
+	_, err = bound.Checks.Read(12345)
+	assert.True(t, errors.Is(err, context.Canceled))
+
+	req, err = client.NewRequest("GET", "/checks", nil)
+	assert.NoError(t, err)
+	assert.Nil(t, req.Context().Value(key{}), "the original client should not be bound")
+}
+
 func TestNewRequest(t *testing.T) {
 	setup()
 	defer teardown()
@@ -86,6 +220,132 @@ func TestNewRequest(t *testing.T) {
 	assert.Equal(t, client.BaseURL.String()+"/checks", req.URL.String())
 }
 
+func TestNewRequestParamsEncoding(t *testing.T) {
+	setup()
+	defer teardown()
+
+	req, err := client.NewRequest("GET", "/checks", map[string]string{
+		"tags":   "prod",
+		"offset": "10",
+		"limit":  "5",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "limit=5&offset=10&tags=prod", req.URL.RawQuery)
+
+	for i := 0; i < 10; i++ {
+		req, err = client.NewRequestMultiParamValue("DELETE", "/maintenance.occurrences", map[string][]string{
+			"occurrenceids": {"3", "1", "2"},
+			"checkids":      {"20", "10"},
+			"a":             {"x"},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "a=x&checkids=20&checkids=10&occurrenceids=3&occurrenceids=1&occurrenceids=2", req.URL.RawQuery)
+	}
+}
+
+func TestNewJSONRequestFromValue(t *testing.T) {
+	setup()
+	defer teardown()
+
+	req, err := client.NewJSONRequestFromValue("POST", "/alerting/teams", struct {
+		Name      string `json:"name"`
+		MemberIDs []int  `json:"member_ids"`
+	}{"Ops", []int{1, 2}})
+	assert.NoError(t, err)
+	assert.Equal(t, "POST", req.Method)
+	assert.Equal(t, client.BaseURL.String()+"/alerting/teams", req.URL.String())
+	assert.Equal(t, "application/json", req.Header.Get("Content-Type"))
+	assert.Equal(t, "Bearer my_api_key", req.Header.Get("Authorization"))
+	body, err := ioutil.ReadAll(req.Body)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"name":"Ops","member_ids":[1,2]}`, string(body))
+
+	_, err = client.NewJSONRequestFromValue("POST", "/alerting/teams", make(chan int))
+	assert.Error(t, err)
+}
+
+func TestNewFormRequest(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/maintenance", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		assert.Equal(t, "application/x-www-form-urlencoded", r.Header.Get("Content-Type"))
+		assert.Empty(t, r.URL.RawQuery)
+		body, err := ioutil.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.Equal(t, "maintenanceids=1%2C2%2C3&z=last", string(body))
+		fmt.Fprint(w, `{"message":"Deletion of maintenance windows was successful!"}`)
+	})
+
+	req, err := client.NewFormRequest("DELETE", "/maintenance", url.Values{
+		"z":              {"last"},
+		"maintenanceids": {"1,2,3"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "Bearer my_api_key", req.Header.Get("Authorization"))
+
+	m := &PingdomResponse{}
+	_, err = client.Do(req, m)
+	assert.NoError(t, err)
+	assert.Equal(t, "Deletion of maintenance windows was successful!", m.Message)
+}
+
+func TestNewRequestUserAgent(t *testing.T) {
+	setup()
+	defer teardown()
+
+	req, err := client.NewRequest("GET", "/checks", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "go-pingdom/"+libraryVersion, req.Header.Get("User-Agent"))
+
+	c, err := NewClientWithConfig(ClientConfig{
+		APIToken:  "key",
+		UserAgent: "my-app go-pingdom/" + libraryVersion,
+	})
+	assert.NoError(t, err)
+
+	req, err = c.NewJSONRequest("POST", "/alerting/contacts", "{}")
+	assert.NoError(t, err)
+	assert.Equal(t, "my-app go-pingdom/"+libraryVersion, req.Header.Get("User-Agent"))
+}
+
+func TestNewRequestAccountEmail(t *testing.T) {
+	setup()
+	defer teardown()
+
+	req, err := client.NewRequest("GET", "/checks", nil)
+	assert.NoError(t, err)
+	assert.NotContains(t, req.Header, "Account-Email")
+
+	c, err := NewClientWithConfig(ClientConfig{
+		APIToken:     "key",
+		AccountEmail: "ops@customer.example.com",
+	})
+	assert.NoError(t, err)
+
+	req, err = c.NewRequest("GET", "/checks", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "ops@customer.example.com", req.Header.Get("Account-Email"))
+
+	req, err = c.NewJSONRequest("POST", "/alerting/contacts", "{}")
+	assert.NoError(t, err)
+	assert.Equal(t, "ops@customer.example.com", req.Header.Get("Account-Email"))
+}
+
+func TestAddAuthHeadersTwice(t *testing.T) {
+	setup()
+	defer teardown()
+
+	req, err := client.NewJSONRequest("POST", "/alerting/teams", "{}")
+	assert.NoError(t, err)
+
+	client.addAuthHeaders(req)
+	assert.Equal(t, []string{"Bearer my_api_key"}, req.Header.Values("Authorization"))
+	assert.Equal(t, []string{defaultUserAgent}, req.Header.Values("User-Agent"))
+	assert.Equal(t, []string{"application/json"}, req.Header.Values("Content-Type"))
+}
+
 func TestDo(t *testing.T) {
 	setup()
 	defer teardown()
@@ -110,6 +370,144 @@ func TestDo(t *testing.T) {
 	assert.Equal(t, want, body)
 }
 
+func TestDoStream(t *testing.T) {
+	setup()
+	defer teardown()
+
+	type foo struct {
+		A string
+	}
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"A":"a"}`)
+	})
+	mux.HandleFunc("/empty", func(w http.ResponseWriter, r *http.Request) {})
+	mux.HandleFunc("/error", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"error":{"statuscode":404,"statusdesc":"Not Found","errormessage":"Nope"}}`)
+	})
+
+	req, _ := client.NewRequest("GET", "/", nil)
+	body := new(foo)
+	_, err := client.doStream(req, body)
+	assert.NoError(t, err)
+	assert.Equal(t, &foo{"a"}, body)
+
+	req, _ = client.NewRequest("GET", "/empty", nil)
+	_, err = client.doStream(req, new(foo))
+	assert.Equal(t, ErrEmptyResponse, err)
+
+	req, _ = client.NewRequest("GET", "/error", nil)
+	resp, err := client.doStream(req, new(foo))
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestDoNoContent(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/checks/12345", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("/checks", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	msg, err := client.Checks.Delete(12345)
+	assert.NoError(t, err)
+	assert.Equal(t, &PingdomResponse{}, msg)
+
+	req, _ := client.NewRequest("DELETE", "/checks/12345", nil)
+	target := &PingdomResponse{Message: "unchanged"}
+	resp, err := client.Do(req, target)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+	assert.Equal(t, &PingdomResponse{Message: "unchanged"}, target)
+
+	checks, err := client.Checks.List()
+	assert.NoError(t, err)
+	assert.Empty(t, checks)
+}
+
+func TestDoEmptyBody(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/checks/1", func(w http.ResponseWriter, r *http.Request) {})
+	mux.HandleFunc("/checks/2", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("/maintenance/1", func(w http.ResponseWriter, r *http.Request) {})
+	mux.HandleFunc("/alerting/contacts/1", func(w http.ResponseWriter, r *http.Request) {})
+
+	_, err := client.Checks.Read(1)
+	assert.Equal(t, ErrEmptyResponse, err)
+	_, err = client.Checks.Read(2)
+	assert.Equal(t, ErrEmptyResponse, err)
+	_, err = client.Checks.Diff(1, &HttpCheck{Name: "n", Hostname: "h"})
+	assert.Equal(t, ErrEmptyResponse, err)
+	_, err = client.Maintenances.ReadWithOptions(1, MaintenanceReadOptions{Resolve: true})
+	assert.Equal(t, ErrEmptyResponse, err)
+	_, err = client.Contacts.Read(1)
+	assert.Equal(t, ErrEmptyResponse, err)
+
+	req, _ := client.NewRequest("GET", "/checks/1", nil)
+	_, err = client.Do(req, &PingdomResponse{})
+	assert.NoError(t, err)
+}
+
+func TestDoRawResponse(t *testing.T) {
+	setup()
+	defer teardown()
+
+	type foo struct {
+		A string
+	}
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"A":"a","B":"not modelled"}`)
+	})
+
+	req, _ := client.NewRequest("GET", "/", nil)
+	body := new(foo)
+	raw := &RawResponse{Value: body}
+
+	_, err := client.Do(req, raw)
+	assert.NoError(t, err)
+	assert.Equal(t, &foo{"a"}, body)
+	assert.JSONEq(t, `{"A":"a","B":"not modelled"}`, string(raw.Body))
+
+	req, _ = client.NewRequest("GET", "/", nil)
+	raw = &RawResponse{}
+
+	_, err = client.Do(req, raw)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"A":"a","B":"not modelled"}`, string(raw.Body))
+}
+
+func TestDoRaw(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"A":"a","B":"not modelled"}`)
+	})
+
+	req, _ := client.NewRequest("GET", "/", nil)
+	body, resp, err := client.DoRaw(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.JSONEq(t, `{"A":"a","B":"not modelled"}`, string(body))
+
+	req, _ = client.NewRequest("GET", "/", nil)
+	var msg json.RawMessage
+	_, err = client.Do(req, &msg)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"A":"a","B":"not modelled"}`, string(msg))
+}
+
 func TestValidateResponse(t *testing.T) {
 	valid := &http.Response{
 		Request:    &http.Request{},
@@ -131,6 +529,71 @@ func TestValidateResponse(t *testing.T) {
 		}`)),
 	}
 
-	want := &PingdomError{400, "Bad Request", "This is an error"}
+	want := &PingdomError{StatusCode: 400, StatusDesc: "Bad Request", Message: "This is an error"}
 	assert.Equal(t, want, validateResponse(invalid))
+
+	noDetails := &http.Response{
+		Request:    &http.Request{},
+		StatusCode: http.StatusNotFound,
+		Body:       ioutil.NopCloser(strings.NewReader(`{}`)),
+	}
+
+	want = &PingdomError{StatusCode: 404, StatusDesc: "Not Found"}
+	assert.Equal(t, want, validateResponse(noDetails))
+}
+
+func TestValidateResponseNonJSON(t *testing.T) {
+	gateway := &http.Response{
+		Request:    &http.Request{},
+		StatusCode: http.StatusBadGateway,
+		Body: ioutil.NopCloser(strings.NewReader(`
+<html>
+<head><title>502 Bad Gateway</title></head>
+<body>` + strings.Repeat("x", 300) + `</body>
+</html>`)),
+	}
+
+	err := validateResponse(gateway)
+	var pe *PingdomError
+	assert.True(t, errors.As(err, &pe))
+	assert.Equal(t, http.StatusBadGateway, pe.StatusCode)
+	assert.Equal(t, "Bad Gateway", pe.StatusDesc)
+	assert.True(t, strings.HasPrefix(pe.Message, "<html>\n<head><title>502 Bad Gateway</title></head>"))
+	assert.True(t, strings.HasSuffix(pe.Message, "xxx..."))
+	assert.Len(t, pe.Message, maxBodySnippet+len("..."))
+}
+
+// largeResultsBody returns a results response holding n results.
+func largeResultsBody(n int) []byte {
+	var b strings.Builder
+	b.WriteString(`{"activeprobes": [1, 2, 3], "results": [`)
+	for i := 0; i < n; i++ {
+		if i > 0 {
+			b.WriteString(",")
+		}
+		fmt.Fprintf(&b, `{"probeid": %d, "time": %d, "status": "up", "responsetime": 231, "statusdesc": "OK", "statusdesclong": "OK"}`, i%3+1, 1563386400-i*60)
+	}
+	b.WriteString(`]}`)
+	return []byte(b.String())
+}
+
+func benchmarkDecode(b *testing.B, decode func(*http.Response, interface{}) error) {
+	body := largeResultsBody(1000)
+	b.ReportAllocs()
+	b.SetBytes(int64(len(body)))
+	for i := 0; i < b.N; i++ {
+		resp := &http.Response{Body: ioutil.NopCloser(bytes.NewReader(body))}
+		m := &ResultsResponse{}
+		if err := decode(resp, m); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkDecodeResponseResults(b *testing.B) {
+	benchmarkDecode(b, decodeResponse)
+}
+
+func BenchmarkDecodeStreamResults(b *testing.B) {
+	benchmarkDecode(b, decodeStream)
 }
@@ -1,8 +1,8 @@
 package pingdom
 
 import (
-	"encoding/json"
-	"io/ioutil"
+	"fmt"
+	"sort"
 )
 
 // ProbeService provides an interface to Pingdom probes.
@@ -10,7 +10,9 @@ type ProbeService struct {
 	client *Client
 }
 
-// List return a list of probes from Pingdom.
+// List return a list of probes from Pingdom.  The supported params are
+// limit, offset, onlyactive and includedeleted; see ProbeListQuery for a
+// typed way to build them.
 func (cs *ProbeService) List(params ...map[string]string) ([]ProbeResponse, error) {
 	param := map[string]string{}
 	if len(params) == 1 {
@@ -21,21 +23,69 @@ func (cs *ProbeService) List(params ...map[string]string) ([]ProbeResponse, erro
 		return nil, err
 	}
 
-	resp, err := cs.client.client.Do(req)
-	if err != nil {
+	p := &listProbesJSONResponse{}
+	if _, err := cs.client.doStream(req, p); err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
+	return p.Probes, nil
+}
 
-	if err := validateResponse(resp); err != nil {
+// ListWithQuery returns the probes matching the given query.  The optional
+// overrides are raw params merged over those of the query, for params the
+// query does not model yet.
+func (cs *ProbeService) ListWithQuery(query ProbeListQuery, overrides ...map[string]string) ([]ProbeResponse, error) {
+	if err := query.Valid(); err != nil {
 		return nil, err
 	}
 
-	bodyBytes, _ := ioutil.ReadAll(resp.Body)
-	bodyString := string(bodyBytes)
+	return cs.List(mergeParams(append([]map[string]string{query.GetParams()}, overrides...)...))
+}
 
-	p := &listProbesJSONResponse{}
-	err = json.Unmarshal([]byte(bodyString), &p)
+// ListIPv6 returns the active probes that can run IPv6 checks.
+func (cs *ProbeService) ListIPv6() ([]ProbeResponse, error) {
+	probes, err := cs.List(map[string]string{"onlyactive": "true"})
+	if err != nil {
+		return nil, err
+	}
+
+	ipv6 := []ProbeResponse{}
+	for _, probe := range probes {
+		if probe.SupportsIPv6() {
+			ipv6 = append(ipv6, probe)
+		}
+	}
+	return ipv6, nil
+}
 
-	return p.Probes, err
+// ListReliable returns the active probes whose share of failed results for
+// the given check, over the client's default time window, is at most
+// maxErrorRate.  Probes without results for the check are kept.  The probes
+// are sorted by error rate, so the first one is the best choice to run a
+// single test or a traceroute from.
+func (cs *ProbeService) ListReliable(checkID int, maxErrorRate float64) ([]ProbeResponse, error) {
+	if maxErrorRate < 0 || maxErrorRate > 1 {
+		return nil, fmt.Errorf("invalid value %v for `maxErrorRate`, must be between 0 and 1", maxErrorRate)
+	}
+
+	results, err := cs.client.Checks.Results(checkID)
+	if err != nil {
+		return nil, err
+	}
+	rates := results.ErrorRatesByProbe()
+
+	probes, err := cs.List(map[string]string{"onlyactive": "true"})
+	if err != nil {
+		return nil, err
+	}
+
+	reliable := []ProbeResponse{}
+	for _, probe := range probes {
+		if rates[probe.ID] <= maxErrorRate {
+			reliable = append(reliable, probe)
+		}
+	}
+	sort.SliceStable(reliable, func(i, j int) bool {
+		return rates[reliable[i].ID] < rates[reliable[j].ID]
+	})
+	return reliable, nil
 }
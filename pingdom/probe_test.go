@@ -3,6 +3,7 @@ package pingdom
 import (
 	"fmt"
 	"net/http"
+	"net/url"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -76,3 +77,124 @@ func TestProbesServiceList(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, want, probes, "Probes.List() should return correct result")
 }
+
+func TestProbesServiceListWithQuery(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/probes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		assert.Equal(t, url.Values{"onlyactive": {"true"}, "limit": {"1"}}, r.URL.Query())
+		fmt.Fprint(w, `{
+			"probes": [
+				{
+					"id": 32,
+					"country": "United States",
+					"city": "Los Angeles",
+					"name": "Los Angeles, CA",
+					"active": true,
+					"hostname": "s410.pingdom.com",
+					"ip": "204.152.200.42",
+					"countryiso": "US",
+					"ipv6": "2607:fcd0:100:8d00::410",
+					"region": "NA"
+				}
+			]
+		}`)
+	})
+	want := []ProbeResponse{
+		{
+			ID:         32,
+			Country:    "United States",
+			City:       "Los Angeles",
+			Name:       "Los Angeles, CA",
+			Active:     true,
+			Hostname:   "s410.pingdom.com",
+			IP:         "204.152.200.42",
+			IPv6:       "2607:fcd0:100:8d00::410",
+			CountryISO: "US",
+			Region:     "NA",
+		},
+	}
+
+	probes, err := client.Probes.ListWithQuery(ProbeListQuery{OnlyActive: true, Limit: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, want, probes)
+}
+
+func TestProbesServiceListWithQueryOverrides(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/probes", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, url.Values{"onlyactive": {"true"}, "limit": {"5"}, "region": {"EU"}}, r.URL.Query())
+		fmt.Fprint(w, `{"probes": []}`)
+	})
+
+	_, err := client.Probes.ListWithQuery(ProbeListQuery{OnlyActive: true, Limit: 1}, map[string]string{"limit": "5", "region": "EU"})
+	assert.NoError(t, err)
+}
+
+func TestProbesServiceListIPv6(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/probes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		assert.Equal(t, "true", r.URL.Query().Get("onlyactive"))
+		fmt.Fprint(w, `{
+			"probes": [
+				{"id": 1, "name": "Amsterdam", "active": true, "ip": "185.39.146.214", "ipv6": "2a02:6ea0:c020::1"},
+				{"id": 2, "name": "Frankfurt", "active": true, "ip": "185.180.12.65", "ipv6": ""},
+				{"id": 3, "name": "Stockholm", "active": true, "ip": "46.246.93.66"}
+			]
+		}`)
+	})
+
+	probes, err := client.Probes.ListIPv6()
+	assert.NoError(t, err)
+	assert.Equal(t, []ProbeResponse{
+		{ID: 1, Name: "Amsterdam", Active: true, IP: "185.39.146.214", IPv6: "2a02:6ea0:c020::1"},
+	}, probes)
+}
+
+func TestProbesServiceListReliable(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/results/85975", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{
+			"activeprobes": [1, 2, 3],
+			"results": [
+				{"probeid": 1, "status": "up"},
+				{"probeid": 1, "status": "down"},
+				{"probeid": 1, "status": "up"},
+				{"probeid": 1, "status": "up"},
+				{"probeid": 2, "status": "down"},
+				{"probeid": 2, "status": "unconfirmed_down"},
+				{"probeid": 2, "status": "up"},
+				{"probeid": 2, "status": "down"}
+			]
+		}`)
+	})
+	mux.HandleFunc("/probes", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "true", r.URL.Query().Get("onlyactive"))
+		fmt.Fprint(w, `{
+			"probes": [
+				{"id": 1, "name": "Amsterdam", "active": true},
+				{"id": 2, "name": "Frankfurt", "active": true},
+				{"id": 3, "name": "Stockholm", "active": true}
+			]
+		}`)
+	})
+
+	probes, err := client.Probes.ListReliable(85975, 0.25)
+	assert.NoError(t, err)
+	assert.Equal(t, []ProbeResponse{
+		{ID: 3, Name: "Stockholm", Active: true},
+		{ID: 1, Name: "Amsterdam", Active: true},
+	}, probes)
+
+	_, err = client.Probes.ListReliable(85975, 2)
+	assert.Error(t, err)
+}
@@ -0,0 +1,50 @@
+package pingdom
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// ProbeListQuery holds the filters supported when listing probes.
+type ProbeListQuery struct {
+	Limit          int
+	Offset         int
+	OnlyActive     bool
+	IncludeDeleted bool
+}
+
+// Valid determines whether a ProbeListQuery contains valid fields for the Pingdom API.
+func (q ProbeListQuery) Valid() error {
+	if q.Limit < 0 {
+		return fmt.Errorf("invalid value for `Limit`, must not be negative")
+	}
+
+	if q.Offset < 0 {
+		return fmt.Errorf("invalid value for `Offset`, must not be negative")
+	}
+
+	return nil
+}
+
+// GetParams returns a map of params for a Pingdom ProbeListQuery.
+func (q ProbeListQuery) GetParams() map[string]string {
+	params := make(map[string]string)
+
+	if q.Limit != 0 {
+		params["limit"] = strconv.Itoa(q.Limit)
+	}
+
+	if q.Offset != 0 {
+		params["offset"] = strconv.Itoa(q.Offset)
+	}
+
+	if q.OnlyActive {
+		params["onlyactive"] = "true"
+	}
+
+	if q.IncludeDeleted {
+		params["includedeleted"] = "true"
+	}
+
+	return params
+}
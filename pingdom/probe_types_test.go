@@ -0,0 +1,24 @@
+package pingdom
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProbeListQueryValid(t *testing.T) {
+	assert.NoError(t, ProbeListQuery{}.Valid())
+	assert.NoError(t, ProbeListQuery{Limit: 10, Offset: 20}.Valid())
+	assert.Error(t, ProbeListQuery{Limit: -1}.Valid())
+	assert.Error(t, ProbeListQuery{Offset: -1}.Valid())
+}
+
+func TestProbeListQueryGetParams(t *testing.T) {
+	assert.Equal(t, map[string]string{}, ProbeListQuery{}.GetParams())
+	assert.Equal(t, map[string]string{
+		"limit":          "10",
+		"offset":         "20",
+		"onlyactive":     "true",
+		"includedeleted": "true",
+	}, ProbeListQuery{Limit: 10, Offset: 20, OnlyActive: true, IncludeDeleted: true}.GetParams())
+}
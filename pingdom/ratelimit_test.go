@@ -0,0 +1,75 @@
+package pingdom
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimiterReserve(t *testing.T) {
+	now := time.Unix(1600000000, 0)
+	l := newRateLimiter(2, 2)
+	l.now = func() time.Time { return now }
+
+	assert.Equal(t, time.Duration(0), l.reserve())
+	assert.Equal(t, time.Duration(0), l.reserve())
+	assert.Equal(t, 500*time.Millisecond, l.reserve())
+	assert.Equal(t, time.Second, l.reserve())
+
+	now = now.Add(2 * time.Second)
+	assert.Equal(t, time.Duration(0), l.reserve())
+
+	now = now.Add(time.Hour)
+	l.reserve()
+	l.reserve()
+	assert.Equal(t, 500*time.Millisecond, l.reserve(), "tokens should not exceed the burst")
+}
+
+func TestRateLimiterWaitCancelled(t *testing.T) {
+	l := newRateLimiter(0.001, 1)
+	assert.NoError(t, l.Wait(context.Background()))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.Equal(t, context.DeadlineExceeded, l.Wait(ctx))
+	assert.InDelta(t, 0, l.tokens, 0.01, "a cancelled wait should give its token back")
+}
+
+func TestClientRateLimit(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/checks/12345", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"check":{"id":12345}}`)
+	})
+
+	client.limiter = newRateLimiter(100, 1)
+
+	start := time.Now()
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := client.Checks.Read(12345)
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+	assert.True(t, time.Since(start) >= 40*time.Millisecond, "5 requests at 100 rps should take at least 40ms")
+}
+
+func TestWithRateLimit(t *testing.T) {
+	c, err := NewClientWithConfig(ClientConfig{APIToken: "key", RateLimit: 10, RateLimitBurst: 5})
+	assert.NoError(t, err)
+	assert.Equal(t, 10.0, c.limiter.rate)
+	assert.Equal(t, 5.0, c.limiter.burst)
+
+	_, err = NewClient("key", WithRateLimit(-1, 1))
+	assert.Error(t, err)
+}
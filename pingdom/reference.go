@@ -0,0 +1,151 @@
+package pingdom
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ReferenceService provides an interface to the Pingdom reference data.
+type ReferenceService struct {
+	client *Client
+}
+
+// Get returns the reference data of the account: regions, time zones, date
+// time and number formats, countries and phone codes.
+func (rs *ReferenceService) Get() (*References, error) {
+	req, err := rs.client.NewRequest("GET", "/reference", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	m := &References{}
+	_, err = rs.client.Do(req, m)
+	if err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// LoadedReferences returns the reference data loaded when the client was
+// created with reference preloading, or nil when it was not loaded.
+func (pc *Client) LoadedReferences() *References {
+	return pc.references
+}
+
+// validPhoneCode checks an SMS country code, with or without a leading "+",
+// against the preloaded phone codes.  Codes are not checked when the
+// reference data is not loaded, and an empty code is left to the API.
+func (pc *Client) validPhoneCode(code string) error {
+	if pc.references == nil || len(pc.references.PhoneCodes) == 0 || code == "" {
+		return nil
+	}
+	if !pc.references.HasPhoneCode(strings.TrimPrefix(code, "+")) {
+		return fmt.Errorf("invalid value %q for `CountryCode`, unknown phone code", code)
+	}
+	return nil
+}
+
+// ValidRegion checks a region ID against the preloaded reference data.  It
+// returns nil when the reference data is not loaded.
+func (pc *Client) ValidRegion(id int) error {
+	if pc.references == nil || len(pc.references.Regions) == 0 || pc.references.HasRegion(id) {
+		return nil
+	}
+	return fmt.Errorf("invalid value %d for `RegionID`, unknown region", id)
+}
+
+// ValidTimezone checks a time zone ID against the preloaded reference data.
+// It returns nil when the reference data is not loaded.
+func (pc *Client) ValidTimezone(id int) error {
+	if pc.references == nil || len(pc.references.Timezones) == 0 || pc.references.HasTimezone(id) {
+		return nil
+	}
+	return fmt.Errorf("invalid value %d for `TimezoneID`, unknown time zone", id)
+}
+
+// ValidDateTimeFormat checks a date time format ID against the preloaded
+// reference data.  It returns nil when the reference data is not loaded.
+func (pc *Client) ValidDateTimeFormat(id int) error {
+	if pc.references == nil || len(pc.references.DateTimeFormats) == 0 || pc.references.HasDateTimeFormat(id) {
+		return nil
+	}
+	return fmt.Errorf("invalid value %d for `DateTimeFormatID`, unknown date time format", id)
+}
+
+// ValidNumberFormat checks a number format ID against the preloaded
+// reference data.  It returns nil when the reference data is not loaded.
+func (pc *Client) ValidNumberFormat(id int) error {
+	if pc.references == nil || len(pc.references.NumberFormats) == 0 || pc.references.HasNumberFormat(id) {
+		return nil
+	}
+	return fmt.Errorf("invalid value %d for `NumberFormatID`, unknown number format", id)
+}
+
+// validContactReferences checks the parts of a contact that depend on the
+// reference data.
+func (pc *Client) validContactReferences(contact ContactAPI) error {
+	c, ok := contact.(*Contact)
+	if !ok {
+		return nil
+	}
+
+	for _, sms := range c.NotificationTargets.SMS {
+		if err := pc.validPhoneCode(sms.CountryCode); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// HasRegion reports whether the reference data contains the given region ID.
+func (r *References) HasRegion(id int) bool {
+	for _, region := range r.Regions {
+		if region.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// HasTimezone reports whether the reference data contains the given time zone ID.
+func (r *References) HasTimezone(id int) bool {
+	for _, tz := range r.Timezones {
+		if tz.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// HasDateTimeFormat reports whether the reference data contains the given
+// date time format ID.
+func (r *References) HasDateTimeFormat(id int) bool {
+	for _, f := range r.DateTimeFormats {
+		if f.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// HasNumberFormat reports whether the reference data contains the given
+// number format ID.
+func (r *References) HasNumberFormat(id int) bool {
+	for _, f := range r.NumberFormats {
+		if f.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// HasPhoneCode reports whether the reference data contains the given phone
+// code, such as "46".
+func (r *References) HasPhoneCode(code string) bool {
+	for _, pc := range r.PhoneCodes {
+		if pc.PhoneCode == code {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,131 @@
+package pingdom
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const referencesJSON = `{
+	"regions": [
+		{
+			"id": 13,
+			"description": "Sweden",
+			"countryid": 12,
+			"datetimeformatid": 2,
+			"numberformatid": 1,
+			"timezoneid": 41
+		}
+	],
+	"timezones": [{"id": 41, "description": "(GMT +01:00) Stockholm"}],
+	"datetimeformats": [{"id": 2, "description": "yyyy-mm-dd HH:MM:SS"}],
+	"numberformats": [{"id": 1, "description": "123,456,789.00"}],
+	"countries": [{"id": 12, "iso": "SE"}],
+	"phonecodes": [{"countryid": 12, "name": "Sweden", "phonecode": "46"}]
+}`
+
+func TestReferenceServiceGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/reference", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, referencesJSON)
+	})
+
+	want := &References{
+		Regions: []ReferenceRegion{
+			{ID: 13, Description: "Sweden", CountryID: 12, DateTimeFormatID: 2, NumberFormatID: 1, TimezoneID: 41},
+		},
+		Timezones:       []ReferenceItem{{ID: 41, Description: "(GMT +01:00) Stockholm"}},
+		DateTimeFormats: []ReferenceItem{{ID: 2, Description: "yyyy-mm-dd HH:MM:SS"}},
+		NumberFormats:   []ReferenceItem{{ID: 1, Description: "123,456,789.00"}},
+		Countries:       []ReferenceCountry{{ID: 12, ISO: "SE"}},
+		PhoneCodes:      []ReferencePhoneCode{{CountryID: 12, Name: "Sweden", PhoneCode: "46"}},
+	}
+
+	refs, err := client.References.Get()
+	assert.NoError(t, err)
+	assert.Equal(t, want, refs)
+
+	assert.True(t, refs.HasRegion(13))
+	assert.False(t, refs.HasRegion(14))
+	assert.True(t, refs.HasTimezone(41))
+	assert.False(t, refs.HasTimezone(1))
+	assert.True(t, refs.HasDateTimeFormat(2))
+	assert.True(t, refs.HasNumberFormat(1))
+	assert.True(t, refs.HasPhoneCode("46"))
+	assert.False(t, refs.HasPhoneCode("47"))
+}
+
+func TestPreloadReferences(t *testing.T) {
+	setup()
+	defer teardown()
+
+	calls := 0
+	mux.HandleFunc("/reference", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		fmt.Fprint(w, referencesJSON)
+	})
+	mux.HandleFunc("/alerting/contacts", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"contact":{"id":1}}`)
+	})
+
+	c, err := NewClientWithConfig(ClientConfig{
+		APIToken:          "key",
+		BaseURL:           server.URL,
+		PreloadReferences: true,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, calls)
+	assert.NotNil(t, c.LoadedReferences())
+
+	contact := func(code string) *Contact {
+		return &Contact{
+			Name: "contact",
+			NotificationTargets: NotificationTargets{
+				SMS: []SMSNotification{{CountryCode: code, Number: "123456789", Provider: "nexmo"}},
+			},
+		}
+	}
+
+	_, err = c.Contacts.Create(contact("46"))
+	assert.NoError(t, err)
+	_, err = c.Contacts.Create(contact("+46"))
+	assert.NoError(t, err)
+
+	_, err = c.Contacts.Create(contact("47"))
+	assert.EqualError(t, err, "invalid value \"47\" for `CountryCode`, unknown phone code")
+	assert.Equal(t, 1, calls)
+
+	assert.NoError(t, c.ValidRegion(13))
+	assert.EqualError(t, c.ValidRegion(14), "invalid value 14 for `RegionID`, unknown region")
+	assert.NoError(t, c.ValidTimezone(41))
+	assert.Error(t, c.ValidTimezone(1))
+	assert.NoError(t, c.ValidDateTimeFormat(2))
+	assert.Error(t, c.ValidDateTimeFormat(3))
+	assert.NoError(t, c.ValidNumberFormat(1))
+	assert.Error(t, c.ValidNumberFormat(2))
+}
+
+func TestPreloadReferencesFailure(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/reference", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprint(w, `{"error":{"statuscode":500,"statusdesc":"Internal Server Error","errormessage":"Oops"}}`)
+	})
+
+	c, err := NewClient("key", WithBaseURL(server.URL), WithPreloadReferences())
+	assert.NoError(t, err)
+	assert.Nil(t, c.LoadedReferences())
+
+	assert.NoError(t, c.validPhoneCode("47"))
+	assert.NoError(t, c.validPhoneCode("+1"))
+	assert.NoError(t, c.validPhoneCode(""))
+	assert.NoError(t, c.ValidRegion(14))
+	assert.NoError(t, c.ValidTimezone(1))
+}
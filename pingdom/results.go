@@ -0,0 +1,102 @@
+package pingdom
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// httpStatusPattern matches the HTTP status code in result descriptions such
+// as "HTTP Error 500" or "HTTP/1.1 503 Service Unavailable".
+var httpStatusPattern = regexp.MustCompile(`HTTP(?:/\d(?:\.\d)?)?(?: Error)? ([1-5][0-9]{2})\b`)
+
+// resultStatuses are the values accepted by the status filter of the results
+// endpoint.
+var resultStatuses = map[string]bool{
+	"up":          true,
+	"down":        true,
+	"unconfirmed": true,
+	"unknown":     true,
+}
+
+// validResultsStatus determines whether the comma separated statuses are
+// valid values for the status filter of the results endpoint.
+func validResultsStatus(status string) error {
+	for _, s := range strings.Split(status, ",") {
+		if !resultStatuses[strings.TrimSpace(s)] {
+			return fmt.Errorf("invalid status %q in `status`, must be one of up, down, unconfirmed or unknown", s)
+		}
+	}
+	return nil
+}
+
+// HTTPStatusCode returns the HTTP status code recorded by Pingdom for the
+// result, or 0 when the result does not mention one, e.g. for a timeout.
+func (r Result) HTTPStatusCode() int {
+	for _, desc := range []string{r.StatusDesc, r.StatusDescLong} {
+		if m := httpStatusPattern.FindStringSubmatch(desc); m != nil {
+			code, _ := strconv.Atoi(m[1])
+			return code
+		}
+	}
+	return 0
+}
+
+// ProbeBreachRate is the share of the results of a probe whose response time
+// was above a threshold.
+type ProbeBreachRate struct {
+	ProbeID  int
+	Results  int
+	Breaches int
+	Rate     float64
+}
+
+// BreachRatesByProbe groups the results by probe and reports, for each
+// probe, how many results had a response time above the threshold (in
+// milliseconds).  This helps to spot a single slow region.  The rates are
+// sorted by probe ID.
+func (r *ResultsResponse) BreachRatesByProbe(threshold int) []ProbeBreachRate {
+	byProbe := map[int]*ProbeBreachRate{}
+	for _, result := range r.Results {
+		rate, ok := byProbe[result.ProbeID]
+		if !ok {
+			rate = &ProbeBreachRate{ProbeID: result.ProbeID}
+			byProbe[result.ProbeID] = rate
+		}
+		rate.Results++
+		if result.ResponseTime > threshold {
+			rate.Breaches++
+		}
+	}
+
+	rates := make([]ProbeBreachRate, 0, len(byProbe))
+	for _, rate := range byProbe {
+		rate.Rate = float64(rate.Breaches) / float64(rate.Results)
+		rates = append(rates, *rate)
+	}
+	sort.Slice(rates, func(i, j int) bool {
+		return rates[i].ProbeID < rates[j].ProbeID
+	})
+	return rates
+}
+
+// ErrorRatesByProbe returns, for each probe of the results, the share of its
+// results whose status is not "up".
+func (r *ResultsResponse) ErrorRatesByProbe() map[int]float64 {
+	total := map[int]int{}
+	failed := map[int]int{}
+	for _, result := range r.Results {
+		total[result.ProbeID]++
+		if result.Status != "up" {
+			failed[result.ProbeID]++
+		}
+	}
+
+	rates := make(map[int]float64, len(total))
+	for id, n := range total {
+		rates[id] = float64(failed[id]) / float64(n)
+	}
+	return rates
+}
@@ -0,0 +1,65 @@
+package pingdom
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResultsResponseBreachRatesByProbe(t *testing.T) {
+	results := ResultsResponse{
+		ActiveProbes: []int{87, 93},
+		Results: []Result{
+			{ProbeID: 93, ResponseTime: 962},
+			{ProbeID: 87, ResponseTime: 56},
+			{ProbeID: 93, ResponseTime: 1084},
+			{ProbeID: 87, ResponseTime: 1200},
+			{ProbeID: 93, ResponseTime: 395},
+			{ProbeID: 87, ResponseTime: 145},
+			{ProbeID: 93, ResponseTime: 1500},
+			{ProbeID: 87, ResponseTime: 300},
+		},
+	}
+
+	want := []ProbeBreachRate{
+		{ProbeID: 87, Results: 4, Breaches: 1, Rate: 0.25},
+		{ProbeID: 93, Results: 4, Breaches: 3, Rate: 0.75},
+	}
+	assert.Equal(t, want, results.BreachRatesByProbe(500))
+	assert.Empty(t, (&ResultsResponse{}).BreachRatesByProbe(500))
+}
+
+func TestResultsResponseErrorRatesByProbe(t *testing.T) {
+	results := ResultsResponse{
+		Results: []Result{
+			{ProbeID: 93, Status: "down"},
+			{ProbeID: 87, Status: "up"},
+			{ProbeID: 93, Status: "unconfirmed_down"},
+			{ProbeID: 87, Status: "up"},
+			{ProbeID: 93, Status: "up"},
+			{ProbeID: 93, Status: "down"},
+		},
+	}
+
+	assert.Equal(t, map[int]float64{87: 0, 93: 0.75}, results.ErrorRatesByProbe())
+	assert.Empty(t, (&ResultsResponse{}).ErrorRatesByProbe())
+}
+
+func TestResultHTTPStatusCode(t *testing.T) {
+	tests := []struct {
+		name   string
+		result Result
+		want   int
+	}{
+		{name: "http error", result: Result{StatusDesc: "HTTP Error 500", StatusDescLong: "Internal Server Error"}, want: 500},
+		{name: "status line", result: Result{StatusDesc: "Down", StatusDescLong: "HTTP/1.1 503 Service Unavailable"}, want: 503},
+		{name: "timeout", result: Result{StatusDesc: "Timeout (> 30s)", StatusDescLong: "Timeout"}, want: 0},
+		{name: "ok", result: Result{StatusDesc: "OK", StatusDescLong: "OK"}, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.result.HTTPStatusCode())
+		})
+	}
+}
@@ -0,0 +1,129 @@
+package pingdom
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy controls how the client retries requests that failed with a
+// network error, a 429 or a 5xx response.  Only idempotent requests (GET,
+// HEAD, PUT and DELETE) are retried.
+type RetryPolicy struct {
+	// MaxRetries is the number of retries after the first attempt.  Zero
+	// disables retries.
+	MaxRetries int
+	// MinBackoff is the wait before the first retry.  It doubles on every
+	// following retry, up to MaxBackoff.
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+	// RetryBudget, when set, caps the total time spent on a call.  A retry
+	// whose wait, including one requested by Retry-After, would exceed the
+	// budget is not made, and the last response or error is returned.
+	RetryBudget time.Duration
+}
+
+// backoff returns the wait before the given retry, starting at 0.
+func (p RetryPolicy) backoff(retry int) time.Duration {
+	if retry > 30 {
+		retry = 30
+	}
+	d := p.MinBackoff << uint(retry)
+	if p.MaxBackoff != 0 && (d > p.MaxBackoff || d < p.MinBackoff) {
+		return p.MaxBackoff
+	}
+	return d
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case "GET", "HEAD", "PUT", "DELETE":
+		return true
+	}
+	return false
+}
+
+func shouldRetry(resp *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+	return resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500
+}
+
+// retryAfter returns the wait requested by the Retry-After header, if any.
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	if resp == nil {
+		return 0, false
+	}
+	seconds, err := strconv.Atoi(resp.Header.Get("Retry-After"))
+	if err != nil || seconds < 0 {
+		return 0, false
+	}
+	return time.Duration(seconds) * time.Second, true
+}
+
+// doWithRetry sends the request, retrying it according to the client's
+// retry policy.
+func (pc *Client) doWithRetry(req *http.Request) (*http.Response, error) {
+	retries := 0
+	if isIdempotent(req.Method) && (req.Body == nil || req.GetBody != nil) {
+		retries = pc.retry.MaxRetries
+	}
+
+	start := time.Now()
+	for attempt := 0; ; attempt++ {
+		if pc.limiter != nil {
+			if err := pc.limiter.Wait(req.Context()); err != nil {
+				return nil, err
+			}
+		}
+
+		resp, err := pc.client.Do(req)
+		if attempt >= retries || !shouldRetry(resp, err) {
+			return resp, err
+		}
+
+		wait := pc.retry.backoff(attempt)
+		if d, ok := retryAfter(resp); ok {
+			wait = d
+		}
+		if b := pc.retry.RetryBudget; b != 0 && time.Since(start)+wait > b {
+			return resp, err
+		}
+
+		event := Event{
+			Type:    EventRetry,
+			Method:  req.Method,
+			URL:     redactedURL(req).String(),
+			Attempt: attempt + 1,
+			Wait:    wait,
+		}
+		if err != nil {
+			event.Reason = err.Error()
+		} else {
+			event.StatusCode = resp.StatusCode
+			event.Reason = resp.Status
+			if resp.StatusCode == http.StatusTooManyRequests {
+				event.Type = EventRateLimit
+			}
+			resp.Body.Close()
+		}
+		pc.emit(event)
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req.Body = body
+		}
+	}
+}
@@ -0,0 +1,185 @@
+package pingdom
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	p := RetryPolicy{MinBackoff: time.Second, MaxBackoff: 5 * time.Second}
+	assert.Equal(t, time.Second, p.backoff(0))
+	assert.Equal(t, 2*time.Second, p.backoff(1))
+	assert.Equal(t, 4*time.Second, p.backoff(2))
+	assert.Equal(t, 5*time.Second, p.backoff(3))
+	assert.Equal(t, 5*time.Second, p.backoff(100))
+}
+
+func TestClientRetry(t *testing.T) {
+	setup()
+	defer teardown()
+
+	calls := 0
+	mux.HandleFunc("/checks/12345", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			fmt.Fprint(w, `{"error":{"statuscode":503,"statusdesc":"Service Unavailable","errormessage":"Try again"}}`)
+			return
+		}
+		fmt.Fprint(w, `{"message":"Deletion of check was successful!"}`)
+	})
+
+	client.retry = RetryPolicy{MaxRetries: 2, MinBackoff: time.Millisecond}
+
+	msg, err := client.Checks.Delete(12345)
+	assert.NoError(t, err)
+	assert.Equal(t, &PingdomResponse{Message: "Deletion of check was successful!"}, msg)
+	assert.Equal(t, 3, calls)
+}
+
+func TestClientRetryExhausted(t *testing.T) {
+	setup()
+	defer teardown()
+
+	calls := 0
+	mux.HandleFunc("/checks/12345", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusTooManyRequests)
+		fmt.Fprint(w, `{"error":{"statuscode":429,"statusdesc":"Too Many Requests","errormessage":"Slow down"}}`)
+	})
+
+	client.retry = RetryPolicy{MaxRetries: 1, MinBackoff: time.Millisecond}
+
+	_, err := client.Checks.Delete(12345)
+	assert.Equal(t, &PingdomError{StatusCode: 429, StatusDesc: "Too Many Requests", Message: "Slow down"}, err)
+	assert.Equal(t, 2, calls)
+}
+
+func TestClientRetryBudget(t *testing.T) {
+	setup()
+	defer teardown()
+
+	calls := 0
+	mux.HandleFunc("/checks/12345", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			fmt.Fprint(w, `{"error":{"statuscode":503,"statusdesc":"Service Unavailable","errormessage":"Try again"}}`)
+			return
+		}
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+		fmt.Fprint(w, `{"error":{"statuscode":429,"statusdesc":"Too Many Requests","errormessage":"Slow down"}}`)
+	})
+
+	client.retry = RetryPolicy{MaxRetries: 5, MinBackoff: time.Millisecond, RetryBudget: time.Second}
+
+	start := time.Now()
+	_, err := client.Checks.Delete(12345)
+	assert.Equal(t, &PingdomError{StatusCode: 429, StatusDesc: "Too Many Requests", Message: "Slow down"}, err)
+	assert.Equal(t, 2, calls, "the short backoff fits in the budget, the Retry-After does not")
+	assert.True(t, time.Since(start) < time.Second)
+}
+
+func TestClientRetrySkipsPost(t *testing.T) {
+	setup()
+	defer teardown()
+
+	calls := 0
+	mux.HandleFunc("/checks", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusServiceUnavailable)
+		fmt.Fprint(w, `{"error":{"statuscode":503,"statusdesc":"Service Unavailable","errormessage":"Try again"}}`)
+	})
+
+	client.retry = RetryPolicy{MaxRetries: 2, MinBackoff: time.Millisecond}
+
+	_, err := client.Checks.Create(&HttpCheck{Name: "check", Hostname: "example.com"})
+	assert.Error(t, err)
+	assert.Equal(t, 1, calls)
+}
+
+func TestClientRetryEvents(t *testing.T) {
+	setup()
+	defer teardown()
+
+	calls := 0
+	mux.HandleFunc("/checks/12345", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		switch calls {
+		case 1:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		case 2:
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+		default:
+			fmt.Fprint(w, `{"message":"Deletion of check was successful!"}`)
+		}
+	})
+
+	var events []Event
+	client.retry = RetryPolicy{MaxRetries: 2, MinBackoff: time.Millisecond}
+	client.EventHandler = func(e Event) {
+		events = append(events, e)
+	}
+
+	_, err := client.Checks.Delete(12345)
+	assert.NoError(t, err)
+	assert.Equal(t, []Event{
+		{
+			Type:       EventRetry,
+			Method:     "DELETE",
+			URL:        server.URL + "/checks/12345",
+			Attempt:    1,
+			Reason:     "503 Service Unavailable",
+			StatusCode: 503,
+			Wait:       time.Millisecond,
+		},
+		{
+			Type:       EventRateLimit,
+			Method:     "DELETE",
+			URL:        server.URL + "/checks/12345",
+			Attempt:    2,
+			Reason:     "429 Too Many Requests",
+			StatusCode: 429,
+			Wait:       0,
+		},
+	}, events)
+}
+
+func TestClientRetryEventsRedactCheckPassword(t *testing.T) {
+	setup()
+	defer teardown()
+
+	calls := 0
+	mux.HandleFunc("/checks/12345", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		assert.Equal(t, "admin:s3cret", r.URL.Query().Get("auth"))
+		fmt.Fprint(w, `{"message":"Modification of check was successful!"}`)
+	})
+
+	var events []Event
+	client.retry = RetryPolicy{MaxRetries: 1, MinBackoff: time.Millisecond}
+	client.EventHandler = func(e Event) {
+		events = append(events, e)
+	}
+
+	_, err := client.Checks.Update(12345, &HttpCheck{
+		Name:     "check",
+		Hostname: "example.com",
+		Username: "admin",
+		Password: "s3cret",
+	})
+	assert.NoError(t, err)
+	assert.Len(t, events, 1)
+	assert.Contains(t, events[0].URL, "auth=admin%3A"+redacted)
+	assert.NotContains(t, events[0].URL, "s3cret")
+}
@@ -0,0 +1,85 @@
+package pingdom
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// serverClock holds the offset of the Pingdom server clock from the local
+// one.  It is shared by the copies of a client.
+type serverClock struct {
+	mu     sync.RWMutex
+	offset time.Duration
+}
+
+func (sc *serverClock) get() time.Duration {
+	sc.mu.RLock()
+	defer sc.mu.RUnlock()
+	return sc.offset
+}
+
+func (sc *serverClock) set(offset time.Duration) {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+	sc.offset = offset
+}
+
+// ServerTime returns the current time of the Pingdom server.
+func (pc *Client) ServerTime() (time.Time, error) {
+	req, err := pc.NewRequest("GET", "/servertime", nil)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	m := &serverTimeJSONResponse{}
+	_, err = pc.Do(req, m)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Unix(m.ServerTime, 0), nil
+}
+
+// SyncServerTime fetches the time of the Pingdom server and records its
+// offset from the client's clock, for AdjustedNow.  The server time has a
+// resolution of a second.
+func (pc *Client) SyncServerTime() error {
+	serverTime, err := pc.ServerTime()
+	if err != nil {
+		return err
+	}
+	pc.serverClock.set(serverTime.Sub(pc.now()))
+	return nil
+}
+
+// WatchServerTime calls SyncServerTime every interval, starting right away,
+// to follow the drift of the local clock.  It blocks until the context is
+// done, or a sync fails, and returns the corresponding error.  The interval
+// must be positive.
+func (pc *Client) WatchServerTime(ctx context.Context, interval time.Duration) error {
+	if interval <= 0 {
+		return fmt.Errorf("invalid value for `interval`, must be positive")
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := pc.SyncServerTime(); err != nil {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// AdjustedNow returns the client's current time corrected by the offset of
+// the Pingdom server clock, as last recorded by SyncServerTime.  Before the
+// first sync, it is the client's current time.  It is safe for concurrent
+// use, including with a sync in progress.
+func (pc *Client) AdjustedNow() time.Time {
+	return pc.now().Add(pc.serverClock.get())
+}
@@ -0,0 +1,79 @@
+package pingdom
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientAdjustedNow(t *testing.T) {
+	setup()
+	defer teardown()
+
+	local := time.Unix(1563386400, 0)
+	client.now = func() time.Time { return local }
+
+	serverTime := int64(1563386430)
+	mux.HandleFunc("/servertime", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprintf(w, `{"servertime": %d}`, serverTime)
+	})
+
+	assert.Equal(t, local, client.AdjustedNow(), "no offset before the first sync")
+
+	assert.NoError(t, client.SyncServerTime())
+	assert.Equal(t, time.Unix(1563386430, 0), client.AdjustedNow())
+
+	local = local.Add(time.Minute)
+	assert.Equal(t, time.Unix(1563386490, 0), client.AdjustedNow())
+
+	serverTime = 1563386440
+	assert.NoError(t, client.SyncServerTime())
+	assert.Equal(t, time.Unix(1563386440, 0), client.AdjustedNow())
+	assert.Equal(t, time.Unix(1563386440, 0), client.WithContext(context.Background()).AdjustedNow(), "copies share the offset")
+}
+
+func TestClientWatchServerTime(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/servertime", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"servertime": %d}`, time.Now().Add(time.Hour).Unix())
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		assert.Equal(t, context.Canceled, client.WatchServerTime(ctx, time.Millisecond))
+	}()
+
+	deadline := time.Now().Add(time.Second)
+	for client.AdjustedNow().Sub(time.Now()) < 59*time.Minute && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	assert.InDelta(t, float64(time.Hour), float64(client.AdjustedNow().Sub(time.Now())), float64(2*time.Second))
+
+	cancel()
+	wg.Wait()
+}
+
+func TestClientWatchServerTimeInvalidInterval(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/servertime", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("no sync should be made")
+	})
+
+	for _, interval := range []time.Duration{0, -time.Second} {
+		err := client.WatchServerTime(context.Background(), interval)
+		assert.EqualError(t, err, "invalid value for `interval`, must be positive")
+	}
+}
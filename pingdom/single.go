@@ -0,0 +1,53 @@
+package pingdom
+
+import "fmt"
+
+// SingleCheckService provides an interface to Pingdom single tests, which
+// run a check once without creating it.
+type SingleCheckService struct {
+	client *Client
+}
+
+// singleCheckTypes are the check types supported by single tests.
+var singleCheckTypes = map[CheckType]bool{
+	CheckTypeHTTP:       true,
+	CheckTypeHTTPCustom: true,
+	CheckTypeTCP:        true,
+	CheckTypePing:       true,
+	CheckTypeDNS:        true,
+	CheckTypeUDP:        true,
+	CheckTypeSMTP:       true,
+	CheckTypePOP3:       true,
+	CheckTypeIMAP:       true,
+}
+
+// Run performs a single test of the given type, one of the CheckType
+// constants, against host, and returns its status and response time.  The
+// params are the type specific parameters of the test, such as url or port.
+// Set the probeid param to run the test from a specific probe.
+func (ss *SingleCheckService) Run(host string, checkType CheckType, params map[string]string) (*SingleCheckResult, error) {
+	if host == "" {
+		return nil, fmt.Errorf("invalid value for `host`, must contain non-empty string")
+	}
+
+	if !singleCheckTypes[checkType] {
+		return nil, fmt.Errorf("invalid value %q for `type`, not supported by single tests", checkType)
+	}
+
+	m := mergeParams(params, map[string]string{"host": host, "type": string(checkType)})
+
+	req, err := ss.client.NewRequest("GET", "/single", m)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &singleCheckJSONResponse{}
+	_, err = ss.client.Do(req, r)
+	if err != nil {
+		return nil, err
+	}
+	if r.Result == nil {
+		return nil, ErrEmptyResponse
+	}
+	return r.Result, nil
+}
@@ -0,0 +1,73 @@
+package pingdom
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSingleCheckServiceRun(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/single", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		assert.Equal(t, url.Values{
+			"host":    {"example.com"},
+			"type":    {"tcp"},
+			"port":    {"443"},
+			"probeid": {"33"},
+		}, r.URL.Query())
+		fmt.Fprint(w, `{
+			"result": {
+				"status": "down",
+				"responsetime": 0,
+				"statusdesc": "Timeout",
+				"statusdesclong": "Connection timed out",
+				"probeid": 33,
+				"probedesc": "Amsterdam 2, Netherlands"
+			}
+		}`)
+	})
+
+	want := &SingleCheckResult{
+		Status:         "down",
+		StatusDesc:     "Timeout",
+		StatusDescLong: "Connection timed out",
+		ProbeID:        33,
+		ProbeDesc:      "Amsterdam 2, Netherlands",
+	}
+
+	params := map[string]string{"port": "443", "probeid": "33"}
+	result, err := client.SingleChecks.Run("example.com", CheckTypeTCP, params)
+	assert.NoError(t, err)
+	assert.Equal(t, want, result)
+	assert.Equal(t, map[string]string{"port": "443", "probeid": "33"}, params)
+}
+
+func TestSingleCheckServiceRunInvalid(t *testing.T) {
+	setup()
+	defer teardown()
+
+	_, err := client.SingleChecks.Run("", CheckTypeHTTP, nil)
+	assert.Error(t, err)
+
+	_, err = client.SingleChecks.Run("example.com", CheckType("ftp"), nil)
+	assert.EqualError(t, err, "invalid value \"ftp\" for `type`, not supported by single tests")
+}
+
+func TestSingleCheckServiceRunNoResult(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/single", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{}`)
+	})
+
+	result, err := client.SingleChecks.Run("example.com", CheckTypeHTTP, nil)
+	assert.Equal(t, ErrEmptyResponse, err)
+	assert.Nil(t, result)
+}
@@ -0,0 +1,85 @@
+package pingdom
+
+// StatusOverview sorts the checks of the account by status, telling the
+// checks down during a maintenance window from real incidents.
+type StatusOverview struct {
+	// Up holds the checks that are up.
+	Up []CheckResponse
+	// Expected holds the checks that are down, confirmed or not, while a
+	// maintenance window covering them is in progress.
+	Expected []CheckResponse
+	// Incidents holds the checks that are down, confirmed or not, outside
+	// of any maintenance window.
+	Incidents []CheckResponse
+	// Other holds the paused checks and those with an unknown status.
+	Other []CheckResponse
+}
+
+// StatusOverview lists the checks of the account and classifies them by
+// status.  The maintenance windows in progress, according to the client's
+// clock, are found from their occurrences, so that recurring windows are
+// accounted for.
+func (pc *Client) StatusOverview() (*StatusOverview, error) {
+	checks, err := pc.Checks.List()
+	if err != nil {
+		return nil, err
+	}
+
+	inMaintenance, err := pc.checksInMaintenance()
+	if err != nil {
+		return nil, err
+	}
+
+	overview := &StatusOverview{}
+	for _, check := range checks {
+		switch check.Status {
+		case StatusUp:
+			overview.Up = append(overview.Up, check)
+		case StatusDown, StatusUnconfirmedDown:
+			if inMaintenance[check.ID] {
+				overview.Expected = append(overview.Expected, check)
+			} else {
+				overview.Incidents = append(overview.Incidents, check)
+			}
+		default:
+			overview.Other = append(overview.Other, check)
+		}
+	}
+	return overview, nil
+}
+
+// checksInMaintenance returns the IDs of the uptime checks covered by a
+// maintenance window in progress.
+func (pc *Client) checksInMaintenance() (map[int]bool, error) {
+	now := pc.now().Unix()
+	occurrences, err := pc.Occurrences.List(ListOccurrenceQuery{From: now, To: now})
+	if err != nil {
+		return nil, err
+	}
+
+	active := map[int64]bool{}
+	for _, o := range occurrences {
+		if o.From <= now && now <= o.To {
+			active[o.MaintenanceId] = true
+		}
+	}
+
+	checkIDs := map[int]bool{}
+	if len(active) == 0 {
+		return checkIDs, nil
+	}
+
+	maintenances, err := pc.Maintenances.List()
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range maintenances {
+		if !active[int64(m.ID)] {
+			continue
+		}
+		for _, id := range m.Checks.Uptime {
+			checkIDs[id] = true
+		}
+	}
+	return checkIDs, nil
+}
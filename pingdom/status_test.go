@@ -0,0 +1,49 @@
+package pingdom
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientStatusOverview(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.now = func() time.Time { return time.Unix(1617700000, 0) }
+
+	mux.HandleFunc("/checks", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"checks": [
+			{"id": 1, "name": "web", "status": "up"},
+			{"id": 2, "name": "db", "status": "down"},
+			{"id": 3, "name": "api", "status": "down"},
+			{"id": 4, "name": "cache", "status": "unconfirmed_down"},
+			{"id": 5, "name": "old", "status": "paused"}
+		]}`)
+	})
+	mux.HandleFunc("/maintenance.occurrences", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, url.Values{"from": {"1617700000"}, "to": {"1617700000"}}, r.URL.Query())
+		fmt.Fprint(w, `{"occurrences": [
+			{"id": 11, "maintenanceid": 100, "from": 1617699622, "to": 1617703222}
+		]}`)
+	})
+	mux.HandleFunc("/maintenance", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"maintenance": [
+			{"id": 100, "description": "db upgrade", "checks": {"uptime": [2, 4], "tms": []}},
+			{"id": 200, "description": "next week", "checks": {"uptime": [3], "tms": []}}
+		]}`)
+	})
+
+	overview, err := client.StatusOverview()
+	assert.NoError(t, err)
+	assert.Equal(t, &StatusOverview{
+		Up:        []CheckResponse{{ID: 1, Name: "web", Status: "up"}},
+		Expected:  []CheckResponse{{ID: 2, Name: "db", Status: "down"}, {ID: 4, Name: "cache", Status: "unconfirmed_down"}},
+		Incidents: []CheckResponse{{ID: 3, Name: "api", Status: "down"}},
+		Other:     []CheckResponse{{ID: 5, Name: "old", Status: "paused"}},
+	}, overview)
+}
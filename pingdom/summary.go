@@ -0,0 +1,268 @@
+package pingdom
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// SummaryService provides an interface to Pingdom summary reports.
+type SummaryService struct {
+	client *Client
+}
+
+// HoursOfDay returns the average response time of a check for each hour of
+// the day, in the server's time zone.  The result always holds 24 entries,
+// indexed by hour; hours without data have a zero average response time.
+// When `From` or `To` is not given, the client's default time window is used.
+func (ss *SummaryService) HoursOfDay(checkID int, request SummaryHoursOfDayRequest) ([]SummaryHourOfDay, error) {
+	if err := request.Valid(); err != nil {
+		return nil, err
+	}
+
+	req, err := ss.client.NewRequest("GET", "/summary.hoursofday/"+strconv.Itoa(checkID), ss.client.withDefaultWindow(request.GetParams()))
+	if err != nil {
+		return nil, err
+	}
+
+	m := &summaryHoursOfDayJSONResponse{}
+	_, err = ss.client.Do(req, m)
+	if err != nil {
+		return nil, err
+	}
+
+	hours := make([]SummaryHourOfDay, 24)
+	for i := range hours {
+		hours[i].Hour = i
+	}
+	for _, h := range m.HoursOfDay {
+		if h.Hour < 0 || h.Hour >= len(hours) {
+			return nil, fmt.Errorf("invalid hour %d in hours of day summary", h.Hour)
+		}
+		hours[h.Hour] = h
+	}
+	return hours, nil
+}
+
+// Probes returns the IDs of the probes that monitored a check during a time
+// window, along with the window itself.  When `From` or `To` is not given,
+// the client's default time window is used.
+func (ss *SummaryService) Probes(checkID int, request SummaryProbesRequest) (*SummaryProbes, error) {
+	if err := request.Valid(); err != nil {
+		return nil, err
+	}
+
+	params := ss.client.withDefaultWindow(request.GetParams())
+	req, err := ss.client.NewRequest("GET", "/summary.probes/"+strconv.Itoa(checkID), params)
+	if err != nil {
+		return nil, err
+	}
+
+	m := &summaryProbesJSONResponse{}
+	_, err = ss.client.Do(req, m)
+	if err != nil {
+		return nil, err
+	}
+
+	probes := &SummaryProbes{Probes: m.Probes}
+	probes.From, _ = strconv.ParseInt(params["from"], 10, 64)
+	probes.To, _ = strconv.ParseInt(params["to"], 10, 64)
+	return probes, nil
+}
+
+// RecentOutages returns the n most recent outages of a check, newest first.
+// It pages backwards from now through windows the length of the client's
+// default window, until n outages are found or since is reached.  Fewer
+// outages are returned when the check was down fewer than n times since then.
+// An outage spanning two windows is returned once, whole.
+func (ss *SummaryService) RecentOutages(checkID int, n int, since time.Time) ([]Outage, error) {
+	if n < 1 {
+		return nil, fmt.Errorf("invalid value %d for `n`, must be at least 1", n)
+	}
+	if since.IsZero() {
+		return nil, fmt.Errorf("invalid value for `since`, must be set")
+	}
+
+	window := int64(ss.client.window / time.Second)
+	if window <= 0 {
+		window = int64(defaultWindow / time.Second)
+	}
+	lower := since.Unix()
+
+	outages := []Outage{}
+	for to := ss.client.now().Unix(); to > lower; to -= window {
+		from := to - window
+		if from < lower {
+			from = lower
+		}
+		page, err := ss.outages(checkID, from, to)
+		if err != nil {
+			return nil, err
+		}
+		for _, o := range page {
+			if last := len(outages) - 1; last >= 0 && o.To >= outages[last].From {
+				if o.From < outages[last].From {
+					outages[last].From = o.From
+					outages[last].Duration = time.Duration(outages[last].To-o.From) * time.Second
+				}
+				continue
+			}
+			outages = append(outages, o)
+		}
+
+		// The oldest outage may have started before the window, and is
+		// only complete once the previous window is seen.
+		if len(outages) > n || len(outages) == n && outages[n-1].From > from {
+			break
+		}
+	}
+	if len(outages) > n {
+		outages = outages[:n]
+	}
+	return outages, nil
+}
+
+// outages returns the outages of a check between from and to, newest first.
+func (ss *SummaryService) outages(checkID int, from, to int64) ([]Outage, error) {
+	params := map[string]string{
+		"from":  strconv.FormatInt(from, 10),
+		"to":    strconv.FormatInt(to, 10),
+		"order": "desc",
+	}
+	req, err := ss.client.NewRequest("GET", "/summary.outage/"+strconv.Itoa(checkID), params)
+	if err != nil {
+		return nil, err
+	}
+
+	m := &summaryOutageJSONResponse{}
+	_, err = ss.client.Do(req, m)
+	if err != nil {
+		return nil, err
+	}
+
+	outages := []Outage{}
+	for _, state := range m.Summary.States {
+		if state.Status != "down" {
+			continue
+		}
+		outages = append(outages, Outage{
+			From:     state.TimeFrom,
+			To:       state.TimeTo,
+			Duration: time.Duration(state.TimeTo-state.TimeFrom) * time.Second,
+		})
+	}
+	sort.SliceStable(outages, func(i, j int) bool { return outages[i].From > outages[j].From })
+	return outages, nil
+}
+
+// ErrNotMonitored is returned by SummaryService.Uptime when the check was
+// neither up nor down during the window, e.g. because it did not exist yet or
+// was paused throughout.
+var ErrNotMonitored = errors.New("pingdom: check was not monitored during the window")
+
+// Uptime returns the percentage of time a check was up between from and to,
+// computed as uptime / (uptime + downtime) from summary.average.  Time the
+// check was not monitored is left out; when there is none of uptime and
+// downtime to account for, Uptime returns 0 and ErrNotMonitored.
+func (ss *SummaryService) Uptime(checkID int, from, to time.Time) (float64, error) {
+	if !from.Before(to) {
+		return 0, fmt.Errorf("invalid value for `from`, must be before `to`")
+	}
+
+	params := map[string]string{
+		"from":          strconv.FormatInt(from.Unix(), 10),
+		"to":            strconv.FormatInt(to.Unix(), 10),
+		"includeuptime": "true",
+	}
+	req, err := ss.client.NewRequest("GET", "/summary.average/"+strconv.Itoa(checkID), params)
+	if err != nil {
+		return 0, err
+	}
+
+	m := &summaryAverageJSONResponse{}
+	_, err = ss.client.Do(req, m)
+	if err != nil {
+		return 0, err
+	}
+
+	status := m.Summary.Status
+	total := status.TotalUp + status.TotalDown
+	if total == 0 {
+		return 0, ErrNotMonitored
+	}
+	return 100 * float64(status.TotalUp) / float64(total), nil
+}
+
+// ToCSV writes the performance buckets as CSV, one row per bucket preceded by
+// a header row.  The first column holds the start of the bucket in RFC 3339
+// format and is named after the resolution of the summary: "hour", "day" or
+// "week".
+func (r *SummaryPerformanceResponse) ToCSV(w io.Writer) error {
+	resolution, buckets := r.buckets()
+
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{resolution, "avgresponse", "uptime", "downtime", "unmonitored"}); err != nil {
+		return err
+	}
+	for _, b := range buckets {
+		err := cw.Write([]string{
+			time.Unix(int64(b.StartTime), 0).UTC().Format(time.RFC3339),
+			strconv.Itoa(b.AvgResponse),
+			strconv.Itoa(b.Uptime),
+			strconv.Itoa(b.Downtime),
+			strconv.Itoa(b.Unmonitored),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// buckets returns the resolution of the summary and its buckets.
+func (r *SummaryPerformanceResponse) buckets() (string, []SummaryPerformanceSummary) {
+	switch {
+	case len(r.Summary.Hours) > 0:
+		return "hour", r.Summary.Hours
+	case len(r.Summary.Days) > 0:
+		return "day", r.Summary.Days
+	case len(r.Summary.Weeks) > 0:
+		return "week", r.Summary.Weeks
+	}
+	return "hour", nil
+}
+
+// UnmonitoredMode tells uptime calculations how to count the time during
+// which a check was not monitored, e.g. because it was paused.
+type UnmonitoredMode int
+
+const (
+	// ExcludeUnmonitored leaves unmonitored time out of the calculation.
+	ExcludeUnmonitored UnmonitoredMode = iota
+	// UnmonitoredAsDowntime counts unmonitored time as downtime.
+	UnmonitoredAsDowntime
+)
+
+// Uptime returns the percentage of time the check was up over all the
+// buckets of the summary, counting unmonitored time according to mode.  It
+// returns 0 when there is no time to account for.
+func (r *SummaryPerformanceResponse) Uptime(mode UnmonitoredMode) float64 {
+	_, buckets := r.buckets()
+	var up, total int
+	for _, b := range buckets {
+		up += b.Uptime
+		total += b.Uptime + b.Downtime
+		if mode == UnmonitoredAsDowntime {
+			total += b.Unmonitored
+		}
+	}
+	if total == 0 {
+		return 0
+	}
+	return 100 * float64(up) / float64(total)
+}
@@ -0,0 +1,287 @@
+package pingdom
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSummaryServiceHoursOfDay(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/summary.hoursofday/12345", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		assert.Equal(t, url.Values{
+			"from":       {"1563300000"},
+			"to":         {"1563386400"},
+			"probes":     {"33,34"},
+			"uptimeonly": {"true"},
+		}, r.URL.Query())
+		fmt.Fprint(w, `{
+			"hoursofday": [
+				{"hour": 0, "avgresponse": 200},
+				{"hour": 1, "avgresponse": 210},
+				{"hour": 2, "avgresponse": 220},
+				{"hour": 3, "avgresponse": 230},
+				{"hour": 4, "avgresponse": 240},
+				{"hour": 5, "avgresponse": 250},
+				{"hour": 6, "avgresponse": 260},
+				{"hour": 7, "avgresponse": 270},
+				{"hour": 8, "avgresponse": 280},
+				{"hour": 9, "avgresponse": 290},
+				{"hour": 10, "avgresponse": 300},
+				{"hour": 11, "avgresponse": 310},
+				{"hour": 12, "avgresponse": 320},
+				{"hour": 13, "avgresponse": 330},
+				{"hour": 14, "avgresponse": 340},
+				{"hour": 15, "avgresponse": 350},
+				{"hour": 16, "avgresponse": 360},
+				{"hour": 17, "avgresponse": 370},
+				{"hour": 18, "avgresponse": 380},
+				{"hour": 19, "avgresponse": 390},
+				{"hour": 20, "avgresponse": 400},
+				{"hour": 21, "avgresponse": 410},
+				{"hour": 22, "avgresponse": 420},
+				{"hour": 23, "avgresponse": 430}
+			]
+		}`)
+	})
+
+	want := make([]SummaryHourOfDay, 24)
+	for h := range want {
+		want[h] = SummaryHourOfDay{Hour: h, AvgResponse: 200 + h*10}
+	}
+
+	hours, err := client.Summaries.HoursOfDay(12345, SummaryHoursOfDayRequest{
+		From:       1563300000,
+		To:         1563386400,
+		Probes:     []int{33, 34},
+		UptimeOnly: true,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, want, hours)
+}
+
+func TestSummaryServiceHoursOfDayPartial(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/summary.hoursofday/12345", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"hoursofday": [{"hour": 23, "avgresponse": 120}]}`)
+	})
+
+	hours, err := client.Summaries.HoursOfDay(12345, SummaryHoursOfDayRequest{})
+	assert.NoError(t, err)
+	assert.Len(t, hours, 24)
+	assert.Equal(t, SummaryHourOfDay{Hour: 0}, hours[0])
+	assert.Equal(t, SummaryHourOfDay{Hour: 23, AvgResponse: 120}, hours[23])
+}
+
+func TestSummaryServiceProbes(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/summary.probes/12345", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		assert.Equal(t, url.Values{
+			"from": {"1563300000"},
+			"to":   {"1563386400"},
+		}, r.URL.Query())
+		fmt.Fprint(w, `{"probes": [33, 34, 45]}`)
+	})
+
+	want := &SummaryProbes{
+		Probes: []int{33, 34, 45},
+		From:   1563300000,
+		To:     1563386400,
+	}
+
+	probes, err := client.Summaries.Probes(12345, SummaryProbesRequest{From: 1563300000, To: 1563386400})
+	assert.NoError(t, err)
+	assert.Equal(t, want, probes)
+}
+
+func TestSummaryServiceRecentOutages(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.now = func() time.Time { return time.Unix(1563386400, 0) }
+
+	mux.HandleFunc("/summary.outage/12345", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		assert.Equal(t, url.Values{
+			"from":  {"1563300000"},
+			"to":    {"1563386400"},
+			"order": {"desc"},
+		}, r.URL.Query())
+		fmt.Fprint(w, `{"summary": {"states": [
+			{"status": "up", "timefrom": 1563380000, "timeto": 1563386400},
+			{"status": "down", "timefrom": 1563379700, "timeto": 1563380000},
+			{"status": "up", "timefrom": 1563340000, "timeto": 1563379700},
+			{"status": "down", "timefrom": 1563339940, "timeto": 1563340000},
+			{"status": "unknown", "timefrom": 1563310000, "timeto": 1563339940},
+			{"status": "down", "timefrom": 1563300000, "timeto": 1563310000}
+		]}}`)
+	})
+
+	outages, err := client.Summaries.RecentOutages(12345, 2, time.Unix(1563300000, 0))
+	assert.NoError(t, err)
+	assert.Equal(t, []Outage{
+		{From: 1563379700, To: 1563380000, Duration: 5 * time.Minute},
+		{From: 1563339940, To: 1563340000, Duration: time.Minute},
+	}, outages)
+
+	outages, err = client.Summaries.RecentOutages(12345, 10, time.Unix(1563300000, 0))
+	assert.NoError(t, err)
+	assert.Len(t, outages, 3)
+	assert.Equal(t, int64(1563300000), outages[2].From)
+
+	_, err = client.Summaries.RecentOutages(12345, 0, time.Unix(1563300000, 0))
+	assert.Error(t, err)
+	_, err = client.Summaries.RecentOutages(12345, 2, time.Time{})
+	assert.Error(t, err)
+}
+
+func TestSummaryServiceRecentOutagesPaging(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.now = func() time.Time { return time.Unix(1563386400, 0) }
+	client.window = 24 * time.Hour
+
+	var windows []string
+	mux.HandleFunc("/summary.outage/12345", func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		windows = append(windows, q.Get("from")+"-"+q.Get("to"))
+		switch q.Get("from") {
+		case "1563300000":
+			fmt.Fprint(w, `{"summary": {"states": [
+				{"status": "up", "timefrom": 1563300600, "timeto": 1563386400},
+				{"status": "down", "timefrom": 1563300000, "timeto": 1563300600}
+			]}}`)
+		case "1563213600":
+			fmt.Fprint(w, `{"summary": {"states": [
+				{"status": "down", "timefrom": 1563299400, "timeto": 1563300000},
+				{"status": "up", "timefrom": 1563220060, "timeto": 1563299400},
+				{"status": "down", "timefrom": 1563220000, "timeto": 1563220060},
+				{"status": "up", "timefrom": 1563213600, "timeto": 1563220000}
+			]}}`)
+		case "1563127200":
+			fmt.Fprint(w, `{"summary": {"states": [
+				{"status": "up", "timefrom": 1563130120, "timeto": 1563213600},
+				{"status": "down", "timefrom": 1563130000, "timeto": 1563130120},
+				{"status": "up", "timefrom": 1563127200, "timeto": 1563130000}
+			]}}`)
+		default:
+			t.Errorf("unexpected window %s", windows[len(windows)-1])
+		}
+	})
+
+	since := time.Unix(1563127200, 0)
+	outages, err := client.Summaries.RecentOutages(12345, 2, since)
+	assert.NoError(t, err)
+	assert.Equal(t, []Outage{
+		{From: 1563299400, To: 1563300600, Duration: 20 * time.Minute},
+		{From: 1563220000, To: 1563220060, Duration: time.Minute},
+	}, outages)
+	assert.Equal(t, []string{"1563300000-1563386400", "1563213600-1563300000"}, windows)
+
+	windows = nil
+	outages, err = client.Summaries.RecentOutages(12345, 5, since)
+	assert.NoError(t, err)
+	assert.Len(t, outages, 3)
+	assert.Equal(t, Outage{From: 1563130000, To: 1563130120, Duration: 2 * time.Minute}, outages[2])
+	assert.Equal(t, []string{"1563300000-1563386400", "1563213600-1563300000", "1563127200-1563213600"}, windows)
+}
+
+func TestSummaryServiceUptime(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/summary.average/12345", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		assert.Equal(t, url.Values{
+			"from":          {"1563300000"},
+			"to":            {"1563386400"},
+			"includeuptime": {"true"},
+		}, r.URL.Query())
+		fmt.Fprint(w, `{"summary": {
+			"responsetime": {"from": 1563300000, "to": 1563386400, "avgresponse": 230},
+			"status": {"totalup": 85536, "totaldown": 864, "totalunknown": 3600}
+		}}`)
+	})
+	mux.HandleFunc("/summary.average/23456", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"summary": {"status": {"totalup": 0, "totaldown": 0, "totalunknown": 86400}}}`)
+	})
+
+	from, to := time.Unix(1563300000, 0), time.Unix(1563386400, 0)
+	uptime, err := client.Summaries.Uptime(12345, from, to)
+	assert.NoError(t, err)
+	assert.InDelta(t, 99.0, uptime, 0.001)
+
+	uptime, err = client.Summaries.Uptime(23456, from, to)
+	assert.Equal(t, ErrNotMonitored, err)
+	assert.Equal(t, 0.0, uptime)
+
+	_, err = client.Summaries.Uptime(12345, to, from)
+	assert.Error(t, err)
+}
+
+func TestSummaryPerformanceResponseToCSV(t *testing.T) {
+	tests := []struct {
+		name    string
+		summary SummaryPerformanceMap
+		want    string
+	}{
+		{
+			name: "hours",
+			summary: SummaryPerformanceMap{Hours: []SummaryPerformanceSummary{
+				{StartTime: 1600000000, AvgResponse: 120, Uptime: 3600},
+				{StartTime: 1600003600, AvgResponse: 250, Uptime: 3000, Downtime: 600},
+			}},
+			want: "hour,avgresponse,uptime,downtime,unmonitored\n" +
+				"2020-09-13T12:26:40Z,120,3600,0,0\n" +
+				"2020-09-13T13:26:40Z,250,3000,600,0\n",
+		},
+		{
+			name: "weeks",
+			summary: SummaryPerformanceMap{Weeks: []SummaryPerformanceSummary{
+				{StartTime: 1599436800, AvgResponse: 130, Uptime: 604000, Unmonitored: 800},
+			}},
+			want: "week,avgresponse,uptime,downtime,unmonitored\n" +
+				"2020-09-07T00:00:00Z,130,604000,0,800\n",
+		},
+		{
+			name: "empty",
+			want: "hour,avgresponse,uptime,downtime,unmonitored\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			r := &SummaryPerformanceResponse{Summary: tt.summary}
+			assert.NoError(t, r.ToCSV(&buf))
+			assert.Equal(t, tt.want, buf.String())
+		})
+	}
+}
+
+func TestSummaryPerformanceResponseUptime(t *testing.T) {
+	r := &SummaryPerformanceResponse{Summary: SummaryPerformanceMap{Days: []SummaryPerformanceSummary{
+		{StartTime: 1600000000, Uptime: 86400},
+		{StartTime: 1600086400, Uptime: 42300, Downtime: 900, Unmonitored: 43200},
+	}}}
+
+	assert.InDelta(t, 99.31, r.Uptime(ExcludeUnmonitored), 0.01)
+	assert.InDelta(t, 74.48, r.Uptime(UnmonitoredAsDowntime), 0.01)
+
+	empty := &SummaryPerformanceResponse{}
+	assert.Equal(t, 0.0, empty.Uptime(ExcludeUnmonitored))
+}
@@ -0,0 +1,76 @@
+package pingdom
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// SummaryHoursOfDayRequest is the API request to Pingdom for a hours of day summary.
+type SummaryHoursOfDayRequest struct {
+	From       int64
+	To         int64
+	Probes     []int
+	UptimeOnly bool
+}
+
+// Valid determines whether a SummaryHoursOfDayRequest contains valid fields for the Pingdom API.
+func (r SummaryHoursOfDayRequest) Valid() error {
+	if r.From != 0 && r.To != 0 && r.From > r.To {
+		return fmt.Errorf("invalid value for `From`, must not be after `To`")
+	}
+
+	return nil
+}
+
+// GetParams returns a map of params for a Pingdom SummaryHoursOfDayRequest.
+func (r SummaryHoursOfDayRequest) GetParams() map[string]string {
+	params := make(map[string]string)
+
+	if r.From != 0 {
+		params["from"] = strconv.FormatInt(r.From, 10)
+	}
+
+	if r.To != 0 {
+		params["to"] = strconv.FormatInt(r.To, 10)
+	}
+
+	if len(r.Probes) != 0 {
+		params["probes"] = intListToCDString(r.Probes)
+	}
+
+	if r.UptimeOnly {
+		params["uptimeonly"] = "true"
+	}
+
+	return params
+}
+
+// SummaryProbesRequest is the API request to Pingdom for a probes summary.
+type SummaryProbesRequest struct {
+	From int64
+	To   int64
+}
+
+// Valid determines whether a SummaryProbesRequest contains valid fields for the Pingdom API.
+func (r SummaryProbesRequest) Valid() error {
+	if r.From != 0 && r.To != 0 && r.From > r.To {
+		return fmt.Errorf("invalid value for `From`, must not be after `To`")
+	}
+
+	return nil
+}
+
+// GetParams returns a map of params for a Pingdom SummaryProbesRequest.
+func (r SummaryProbesRequest) GetParams() map[string]string {
+	params := make(map[string]string)
+
+	if r.From != 0 {
+		params["from"] = strconv.FormatInt(r.From, 10)
+	}
+
+	if r.To != 0 {
+		params["to"] = strconv.FormatInt(r.To, 10)
+	}
+
+	return params
+}
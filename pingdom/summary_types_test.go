@@ -0,0 +1,34 @@
+package pingdom
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSummaryHoursOfDayRequestValid(t *testing.T) {
+	assert.NoError(t, SummaryHoursOfDayRequest{}.Valid())
+	assert.NoError(t, SummaryHoursOfDayRequest{From: 1, To: 2}.Valid())
+	assert.Error(t, SummaryHoursOfDayRequest{From: 2, To: 1}.Valid())
+}
+
+func TestSummaryHoursOfDayRequestGetParams(t *testing.T) {
+	assert.Equal(t, map[string]string{}, SummaryHoursOfDayRequest{}.GetParams())
+	assert.Equal(t, map[string]string{
+		"from":       "1",
+		"to":         "2",
+		"probes":     "3,4",
+		"uptimeonly": "true",
+	}, SummaryHoursOfDayRequest{From: 1, To: 2, Probes: []int{3, 4}, UptimeOnly: true}.GetParams())
+}
+
+func TestSummaryProbesRequestValid(t *testing.T) {
+	assert.NoError(t, SummaryProbesRequest{}.Valid())
+	assert.NoError(t, SummaryProbesRequest{From: 1, To: 2}.Valid())
+	assert.Error(t, SummaryProbesRequest{From: 2, To: 1}.Valid())
+}
+
+func TestSummaryProbesRequestGetParams(t *testing.T) {
+	assert.Equal(t, map[string]string{}, SummaryProbesRequest{}.GetParams())
+	assert.Equal(t, map[string]string{"from": "1", "to": "2"}, SummaryProbesRequest{From: 1, To: 2}.GetParams())
+}
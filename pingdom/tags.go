@@ -0,0 +1,134 @@
+package pingdom
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// tagPattern matches the tag names Pingdom accepts, once lowercased.
+var tagPattern = regexp.MustCompile(`^[a-z0-9_.:-]+$`)
+
+// normalizeTags returns the comma separated tags lowercased, with the blanks
+// around the names and empty names removed, as Pingdom stores them.
+func normalizeTags(tags string) string {
+	var names []string
+	for _, name := range strings.Split(tags, ",") {
+		if name = strings.ToLower(strings.TrimSpace(name)); name != "" {
+			names = append(names, name)
+		}
+	}
+	return strings.Join(names, ",")
+}
+
+// validTags determines whether the comma separated tags are valid tag names
+// once normalized.
+func validTags(tags string) error {
+	if tags == "" {
+		return nil
+	}
+	for _, name := range strings.Split(normalizeTags(tags), ",") {
+		if !tagPattern.MatchString(name) {
+			return fmt.Errorf("invalid tag name %q in `Tags`, allowed characters are letters, digits and [_.:-]", name)
+		}
+	}
+	return nil
+}
+
+// tagList returns the given tags validated, normalized and comma separated.
+// At least one tag must be given.
+func tagList(tags []string) (string, error) {
+	joined := normalizeTags(strings.Join(tags, ","))
+	if joined == "" {
+		return "", fmt.Errorf("invalid value for `tags`, must contain at least one tag")
+	}
+	if err := validTags(joined); err != nil {
+		return "", err
+	}
+	return joined, nil
+}
+
+// TagSet is the set of tags of a check, as returned by the Pingdom API.
+// Tags are identified by name.
+type TagSet []CheckResponseTag
+
+// UnmarshalJSON decodes the tags of a check, whether Pingdom returns them as
+// an array of tag objects, an array of names or a comma separated string.
+func (s *TagSet) UnmarshalJSON(b []byte) error {
+	var objects []CheckResponseTag
+	if err := json.Unmarshal(b, &objects); err == nil {
+		*s = objects
+		return nil
+	}
+
+	var names []string
+	if err := json.Unmarshal(b, &names); err != nil {
+		var joined string
+		if err := json.Unmarshal(b, &joined); err != nil {
+			return fmt.Errorf("invalid tags %s, must be an array or a comma separated string", b)
+		}
+		names = strings.Split(joined, ",")
+	}
+
+	tags := TagSet{}
+	for _, name := range names {
+		if name = strings.TrimSpace(name); name != "" {
+			tags = append(tags, CheckResponseTag{Name: name})
+		}
+	}
+	*s = tags
+	return nil
+}
+
+// Has reports whether the set contains a tag with the given name.
+func (s TagSet) Has(name string) bool {
+	for _, t := range s {
+		if t.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Names returns the names of the tags, in order.
+func (s TagSet) Names() []string {
+	names := make([]string, len(s))
+	for i, t := range s {
+		names[i] = t.Name
+	}
+	return names
+}
+
+// Union returns the tags of s followed by the tags of other not in s.
+func (s TagSet) Union(other TagSet) TagSet {
+	u := append(TagSet{}, s...)
+	for _, t := range other {
+		if !u.Has(t.Name) {
+			u = append(u, t)
+		}
+	}
+	return u
+}
+
+// Intersect returns the tags of s that are also in other.
+func (s TagSet) Intersect(other TagSet) TagSet {
+	i := TagSet{}
+	for _, t := range s {
+		if other.Has(t.Name) {
+			i = append(i, t)
+		}
+	}
+	return i
+}
+
+// Difference returns the tags of s that are not in other.
+func (s TagSet) Difference(other TagSet) TagSet {
+	d := TagSet{}
+	for _, t := range s {
+		if !other.Has(t.Name) {
+			d = append(d, t)
+		}
+	}
+	return d
+}
@@ -0,0 +1,70 @@
+package pingdom
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTagSet(t *testing.T) {
+	var check CheckResponse
+	err := json.Unmarshal([]byte(`{
+		"id": 1,
+		"tags": [
+			{"name": "apache", "type": "a", "count": 2},
+			{"name": "prod", "type": "u", "count": 1}
+		]
+	}`), &check)
+	assert.NoError(t, err)
+
+	tags := check.Tags
+	assert.True(t, tags.Has("apache"))
+	assert.True(t, tags.Has("prod"))
+	assert.False(t, tags.Has("nginx"))
+	assert.Equal(t, []string{"apache", "prod"}, tags.Names())
+	assert.Equal(t, []string{}, TagSet{}.Names())
+
+	other := TagSet{{Name: "prod"}, {Name: "nginx"}}
+	assert.Equal(t, []string{"apache", "prod", "nginx"}, tags.Union(other).Names())
+	assert.Equal(t, []string{"prod"}, tags.Intersect(other).Names())
+	assert.Equal(t, []string{"apache"}, tags.Difference(other).Names())
+
+	b, err := json.Marshal(TagSet{{Name: "prod", Type: "u", Count: 1}})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `[{"name":"prod","type":"u","count":1}]`, string(b))
+}
+
+func TestTagSetUnmarshal(t *testing.T) {
+	tests := []struct {
+		json string
+		want TagSet
+	}{
+		{`{"tags":[{"name":"apache","type":"a","count":2}]}`, TagSet{{Name: "apache", Type: "a", Count: float64(2)}}},
+		{`{"tags":["apache","prod"]}`, TagSet{{Name: "apache"}, {Name: "prod"}}},
+		{`{"tags":"apache, prod"}`, TagSet{{Name: "apache"}, {Name: "prod"}}},
+		{`{"tags":""}`, TagSet{}},
+		{`{"tags":null}`, nil},
+		{`{}`, nil},
+	}
+	for _, tt := range tests {
+		var check CheckResponse
+		assert.NoError(t, json.Unmarshal([]byte(tt.json), &check), tt.json)
+		assert.Equal(t, tt.want, check.Tags, tt.json)
+	}
+
+	var check CheckResponse
+	assert.Error(t, json.Unmarshal([]byte(`{"tags":42}`), &check))
+}
+
+func TestNormalizeTags(t *testing.T) {
+	assert.Equal(t, "prod,eu-west,team:web", normalizeTags(" Prod, EU-West,,team:web "))
+	assert.Equal(t, "", normalizeTags(""))
+}
+
+func TestValidTags(t *testing.T) {
+	assert.NoError(t, validTags(""))
+	assert.NoError(t, validTags("Prod, eu_west, v1.2"))
+	assert.Error(t, validTags("prod,front end"))
+	assert.Error(t, validTags("prod,web/api"))
+}
@@ -1,8 +1,6 @@
 package pingdom
 
 import (
-	"encoding/json"
-	"io/ioutil"
 	"strconv"
 )
 
@@ -24,23 +22,11 @@ func (cs *TeamService) List() ([]TeamResponse, error) {
 		return nil, err
 	}
 
-	resp, err := cs.client.client.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	if err := validateResponse(resp); err != nil {
+	t := &listTeamsJSONResponse{}
+	if _, err := cs.client.doStream(req, t); err != nil {
 		return nil, err
 	}
-
-	bodyBytes, _ := ioutil.ReadAll(resp.Body)
-	bodyString := string(bodyBytes)
-
-	t := &listTeamsJSONResponse{}
-	err = json.Unmarshal([]byte(bodyString), &t)
-
-	return t.Teams, err
+	return t.Teams, nil
 }
 
 // Read return a team object from Pingdom.
@@ -71,10 +57,13 @@ func (cs *TeamService) Create(team TeamAPI) (*TeamResponse, error) {
 	}
 
 	t := &teamDetailsJSONResponse{}
-	_, err = cs.client.Do(req, t)
+	resp, err := cs.client.Do(req, t)
 	if err != nil {
 		return nil, err
 	}
+	if t.Team != nil {
+		t.Team.Location = location(resp)
+	}
 	return t.Team, err
 }
 
@@ -28,23 +28,11 @@ func (cs *TMSCheckService) List(params ...map[string]string) ([]TMSCheckResponse
 		return nil, err
 	}
 
-	resp, err := cs.client.client.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	if err := validateResponse(resp); err != nil {
+	t := &listTMSChecksJSONResponse{}
+	if _, err := cs.client.doStream(req, t); err != nil {
 		return nil, err
 	}
-
-	bodyBytes, _ := ioutil.ReadAll(resp.Body)
-	bodyString := string(bodyBytes)
-
-	t := &listTMSChecksJSONResponse{}
-	err = json.Unmarshal([]byte(bodyString), &t)
-
-	return t.TMSChecks, err
+	return t.TMSChecks, nil
 }
 
 func (cs *TMSCheckService) Read(id int) (*TMSCheckDetailResponse, error) {
@@ -53,7 +41,7 @@ func (cs *TMSCheckService) Read(id int) (*TMSCheckDetailResponse, error) {
 		return nil, err
 	}
 
-	resp, err := cs.client.client.Do(req)
+	resp, err := cs.client.send(req)
 	if err != nil {
 		return nil, err
 	}
@@ -83,10 +71,13 @@ func (cs *TMSCheckService) Create(tmsCheck *TMSCheck) (*TMSCheckDetailResponse,
 	}
 
 	t := &tmsChecksDetailJSONResponse{}
-	_, err = cs.client.Do(req, t)
+	resp, err := cs.client.Do(req, t)
 	if err != nil {
 		return nil, err
 	}
+	if t.TMSCheck != nil {
+		t.TMSCheck.Location = location(resp)
+	}
 	return t.TMSCheck, err
 }
 
@@ -60,6 +60,10 @@ func (t *TMSCheck) Valid() error {
 		return fmt.Errorf("Invalid value for `Interval`. Please provide one of the following valid values instead: [5 10 20 60 720 1440].")
 	}
 
+	if err := validCustomMessage(t.CustomMessage); err != nil {
+		return err
+	}
+
 	if t.SeverityLevel != "" && t.SeverityLevel != "high" && t.SeverityLevel != "low" {
 		return fmt.Errorf("Invalid value for `SeverityLevel`. Please provide one of the following valid values instead: [high,low].")
 	}
@@ -2,6 +2,7 @@ package pingdom
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -197,6 +198,22 @@ func TestTMSCheck_Valid(t *testing.T) {
 			},
 			wantErr: fmt.Errorf("Invalid value for `Tags`. The tag name may contain the characters 'A-Z', 'a-z', '0-9', '_' and '-'."),
 		},
+		{
+			name: "InvalidCustomMessage",
+			tmsCheck: TMSCheck{
+				Name: "InvalidCustomMessage",
+				Steps: []TMSCheckStep{
+					{
+						Args: map[string]string{
+							"url": "www.google.com",
+						},
+						Fn: "go_to",
+					},
+				},
+				CustomMessage: strings.Repeat("a", MaxCustomMessageLength+1),
+			},
+			wantErr: fmt.Errorf("invalid value for `CustomMessage`, 1001 characters exceed the limit of 1000"),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -232,6 +249,15 @@ func TestTMSCheck_RenderForJSONAPI(t *testing.T) {
 			},
 			wantJson: `{"name":"RequireParams","steps":[{"args":{"url":"www.google.com"},"fn":"go_to"}],"active":false}`,
 		},
+		{
+			name: "CustomMessage",
+			tmsCheck: TMSCheck{
+				Name:          "CustomMessage",
+				Steps:         []TMSCheckStep{{Args: map[string]string{"url": "www.google.com"}, Fn: "go_to"}},
+				CustomMessage: "Runbook: https://wiki.example.com/runbooks/login",
+			},
+			wantJson: `{"name":"CustomMessage","steps":[{"args":{"url":"www.google.com"},"fn":"go_to"}],"active":false,"custom_message":"Runbook: https://wiki.example.com/runbooks/login"}`,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -0,0 +1,42 @@
+package pingdom
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// TracerouteService provides an interface to Pingdom traceroutes.
+type TracerouteService struct {
+	client *Client
+}
+
+// Run performs a traceroute to host from the given probe, and returns the raw
+// traceroute output along with the probe used.  When probeID is zero, Pingdom
+// picks the probe.
+func (ts *TracerouteService) Run(host string, probeID int) (*TracerouteResult, error) {
+	if host == "" {
+		return nil, fmt.Errorf("invalid value for `host`, must contain non-empty string")
+	}
+
+	params := map[string]string{
+		"host": host,
+	}
+	if probeID != 0 {
+		params["probeid"] = strconv.Itoa(probeID)
+	}
+
+	req, err := ts.client.NewRequest("GET", "/traceroute", params)
+	if err != nil {
+		return nil, err
+	}
+
+	m := &tracerouteJSONResponse{}
+	_, err = ts.client.Do(req, m)
+	if err != nil {
+		return nil, err
+	}
+	if m.Traceroute == nil {
+		return nil, ErrEmptyResponse
+	}
+	return m.Traceroute, nil
+}
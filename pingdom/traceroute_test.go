@@ -0,0 +1,66 @@
+package pingdom
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTracerouteServiceRun(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/traceroute", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		assert.Equal(t, url.Values{"host": {"example.com"}, "probeid": {"23"}}, r.URL.Query())
+		fmt.Fprint(w, `{
+			"traceroute": {
+				"result": "traceroute to example.com (93.184.216.34), 30 hops max, 40 byte packets\n 1  10.0.0.1  0.351 ms\n 2  93.184.216.34  1.021 ms",
+				"probeid": 23,
+				"probedescription": "Stockholm, Sweden"
+			}
+		}`)
+	})
+
+	want := &TracerouteResult{
+		Result:           "traceroute to example.com (93.184.216.34), 30 hops max, 40 byte packets\n 1  10.0.0.1  0.351 ms\n 2  93.184.216.34  1.021 ms",
+		ProbeID:          23,
+		ProbeDescription: "Stockholm, Sweden",
+	}
+
+	result, err := client.Traceroutes.Run("example.com", 23)
+	assert.NoError(t, err)
+	assert.Equal(t, want, result)
+}
+
+func TestTracerouteServiceRunWithoutProbe(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/traceroute", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, url.Values{"host": {"example.com"}}, r.URL.Query())
+		fmt.Fprint(w, `{"traceroute": {"result": "", "probeid": 1, "probedescription": "Amsterdam"}}`)
+	})
+
+	_, err := client.Traceroutes.Run("example.com", 0)
+	assert.NoError(t, err)
+
+	_, err = client.Traceroutes.Run("", 0)
+	assert.Error(t, err)
+}
+
+func TestTracerouteServiceRunNoResult(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/traceroute", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{}`)
+	})
+
+	result, err := client.Traceroutes.Run("example.com", 0)
+	assert.Equal(t, ErrEmptyResponse, err)
+	assert.Nil(t, result)
+}
@@ -0,0 +1,51 @@
+package pingdom
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// maxWebhookSize is the largest webhook payload ParseWebhook reads.
+const maxWebhookSize = 1 << 20
+
+// WebhookAlert is the alert Pingdom posts to a webhook integration when the
+// state of a check changes.
+type WebhookAlert struct {
+	CheckID               int      `json:"check_id"`
+	CheckName             string   `json:"check_name"`
+	CheckType             string   `json:"check_type"`
+	Tags                  []string `json:"tags"`
+	PreviousState         string   `json:"previous_state"`
+	CurrentState          string   `json:"current_state"`
+	ImportanceLevel       string   `json:"importance_level"`
+	StateChangedTimestamp int64    `json:"state_changed_timestamp"`
+	Description           string   `json:"description"`
+	LongDescription       string   `json:"long_description"`
+}
+
+// Time returns the time the state of the check changed.
+func (a WebhookAlert) Time() time.Time {
+	return time.Unix(a.StateChangedTimestamp, 0).UTC()
+}
+
+// ParseWebhook decodes the alert posted by Pingdom to a webhook.  Pingdom
+// does not sign its webhooks, so the payload cannot be authenticated; use a
+// hard to guess webhook URL, or one carrying a secret, to keep others from
+// posting to it.
+func ParseWebhook(r *http.Request) (*WebhookAlert, error) {
+	if r.Method != "POST" {
+		return nil, fmt.Errorf("invalid webhook method %s, must be POST", r.Method)
+	}
+
+	alert := &WebhookAlert{}
+	if err := json.NewDecoder(io.LimitReader(r.Body, maxWebhookSize)).Decode(alert); err != nil {
+		return nil, fmt.Errorf("invalid webhook payload: %w", err)
+	}
+	if alert.CheckID == 0 {
+		return nil, fmt.Errorf("invalid webhook payload, `check_id` is missing")
+	}
+	return alert, nil
+}
@@ -0,0 +1,67 @@
+package pingdom
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var webhookJSON = `{
+	"check_id": 12345,
+	"check_name": "Name of HTTP check",
+	"check_type": "HTTP",
+	"check_params": {
+		"basic_auth": false,
+		"encryption": true,
+		"full_url": "https://www.example.com/path",
+		"header": "User-Agent:Pingdom.com_bot",
+		"hostname": "www.example.com",
+		"ipv6": false,
+		"port": 443,
+		"url": "/path"
+	},
+	"tags": ["example_tag"],
+	"previous_state": "UP",
+	"current_state": "DOWN",
+	"importance_level": "HIGH",
+	"state_changed_timestamp": 1451610061,
+	"state_changed_utc_time": "2016-01-01T01:01:01",
+	"long_description": "Long error message",
+	"description": "Short error message",
+	"first_probe": {"ip": "123.4.5.6", "ipv6": "2001:4800:1020:209::5", "location": "Stockholm, Sweden"},
+	"second_probe": {"ip": "123.4.5.6", "ipv6": "2001:4800:1020:209::5", "location": "Austin, US", "version": 1}
+}`
+
+func TestParseWebhook(t *testing.T) {
+	r := httptest.NewRequest("POST", "/pingdom", strings.NewReader(webhookJSON))
+
+	alert, err := ParseWebhook(r)
+	assert.NoError(t, err)
+	assert.Equal(t, &WebhookAlert{
+		CheckID:               12345,
+		CheckName:             "Name of HTTP check",
+		CheckType:             "HTTP",
+		Tags:                  []string{"example_tag"},
+		PreviousState:         "UP",
+		CurrentState:          "DOWN",
+		ImportanceLevel:       "HIGH",
+		StateChangedTimestamp: 1451610061,
+		Description:           "Short error message",
+		LongDescription:       "Long error message",
+	}, alert)
+	assert.Equal(t, time.Date(2016, 1, 1, 1, 1, 1, 0, time.UTC), alert.Time())
+}
+
+func TestParseWebhookInvalid(t *testing.T) {
+	_, err := ParseWebhook(httptest.NewRequest("GET", "/pingdom", nil))
+	assert.Error(t, err)
+
+	_, err = ParseWebhook(httptest.NewRequest("POST", "/pingdom", strings.NewReader(`not json`)))
+	assert.Error(t, err)
+
+	_, err = ParseWebhook(httptest.NewRequest("POST", "/pingdom", strings.NewReader(`{"check_name":"x"}`)))
+	assert.EqualError(t, err, "invalid webhook payload, `check_id` is missing")
+}
@@ -0,0 +1,81 @@
+package pingdom
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// defaultWindow is the time window used by summary and results requests when
+// neither `from` nor `to` is given.
+const defaultWindow = 24 * time.Hour
+
+// withDefaultWindow returns a copy of params where missing `from` and `to`
+// params are filled in so that they span the client's default window, ending
+// now.  The window does not start before the Unix epoch.  When the default
+// window is zero, params are returned unchanged.
+func (pc *Client) withDefaultWindow(params map[string]string) map[string]string {
+	m := mergeParams(params)
+	if pc.window == 0 {
+		return m
+	}
+
+	if _, ok := m["to"]; !ok {
+		m["to"] = strconv.FormatInt(pc.now().Unix(), 10)
+	}
+	if _, ok := m["from"]; !ok {
+		to, err := strconv.ParseInt(m["to"], 10, 64)
+		if err != nil {
+			return m
+		}
+		from := to - int64(pc.window/time.Second)
+		if from < 0 {
+			from = 0
+		}
+		m["from"] = strconv.FormatInt(from, 10)
+	}
+	return m
+}
+
+// Period is a time window for summary and results requests, which take it
+// as `from` and `to` Unix timestamps:
+//
+//	req := pingdom.SummaryProbesRequest{}
+//	req.From, req.To = pingdom.Last(24 * time.Hour).Unix()
+type Period struct {
+	From time.Time
+	To   time.Time
+}
+
+// Last returns the period of the given duration ending now.
+func Last(d time.Duration) Period {
+	to := time.Now().UTC()
+	return Period{From: to.Add(-d), To: to}
+}
+
+// Valid determines whether the Period spans a non-empty time window.
+func (p Period) Valid() error {
+	if p.From.IsZero() || p.To.IsZero() {
+		return fmt.Errorf("invalid value for `From` and `To`, both must be set")
+	}
+	if p.From.After(p.To) {
+		return fmt.Errorf("invalid value for `From`, must not be after `To`")
+	}
+	return nil
+}
+
+// Unix returns the bounds of the period as UTC epoch seconds, truncating any
+// fraction of a second.
+func (p Period) Unix() (from, to int64) {
+	return p.From.UTC().Unix(), p.To.UTC().Unix()
+}
+
+// Params returns the `from` and `to` params of the period, which can be
+// merged into the params of any request taking a time window.
+func (p Period) Params() map[string]string {
+	from, to := p.Unix()
+	return map[string]string{
+		"from": strconv.FormatInt(from, 10),
+		"to":   strconv.FormatInt(to, 10),
+	}
+}
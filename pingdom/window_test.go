@@ -0,0 +1,87 @@
+package pingdom
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientWithDefaultWindow(t *testing.T) {
+	now := time.Unix(1563386400, 0)
+	c, err := NewClient("key", WithClock(func() time.Time { return now }))
+	assert.NoError(t, err)
+
+	assert.Equal(t, map[string]string{"from": "1563300000", "to": "1563386400", "probes": "1"},
+		c.withDefaultWindow(map[string]string{"probes": "1"}))
+	assert.Equal(t, map[string]string{"from": "1000", "to": "1563386400"},
+		c.withDefaultWindow(map[string]string{"from": "1000"}))
+	assert.Equal(t, map[string]string{"from": "0", "to": "10000"},
+		c.withDefaultWindow(map[string]string{"to": "10000"}))
+
+	c, err = NewClient("key", WithClock(func() time.Time { return now }), WithDefaultWindow(time.Hour))
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"from": "1563382800", "to": "1563386400"},
+		c.withDefaultWindow(nil))
+
+	c, err = NewClient("key", WithDefaultWindow(0))
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{}, c.withDefaultWindow(nil))
+}
+
+func TestCheckServiceResultsDefaultWindow(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.now = func() time.Time { return time.Unix(1563386400, 0) }
+
+	mux.HandleFunc("/results/12345", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, url.Values{
+			"from":  {"1563300000"},
+			"to":    {"1563386400"},
+			"limit": {"10"},
+		}, r.URL.Query())
+		fmt.Fprint(w, `{"activeprobes":[],"results":[]}`)
+	})
+
+	params := map[string]string{"limit": "10"}
+	_, err := client.Checks.Results(12345, params)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"limit": "10"}, params)
+}
+
+func TestLast(t *testing.T) {
+	before := time.Now().Unix()
+	p := Last(24 * time.Hour)
+	after := time.Now().Unix()
+
+	assert.NoError(t, p.Valid())
+	from, to := p.Unix()
+	assert.Equal(t, int64(86400), to-from)
+	assert.True(t, before <= to && to <= after)
+	assert.Equal(t, map[string]string{
+		"from": strconv.FormatInt(from, 10),
+		"to":   strconv.FormatInt(to, 10),
+	}, p.Params())
+}
+
+func TestPeriod(t *testing.T) {
+	paris := time.FixedZone("CEST", 2*60*60)
+	p := Period{
+		From: time.Date(2019, 7, 17, 20, 0, 0, 0, paris),
+		To:   time.Date(2019, 7, 18, 20, 0, 0, 500, paris),
+	}
+	assert.NoError(t, p.Valid())
+	assert.Equal(t, map[string]string{"from": "1563386400", "to": "1563472800"}, p.Params())
+
+	req := SummaryProbesRequest{}
+	req.From, req.To = p.Unix()
+	assert.Equal(t, SummaryProbesRequest{From: 1563386400, To: 1563472800}, req)
+
+	assert.Error(t, Period{From: p.To, To: p.From}.Valid())
+	assert.Error(t, Period{To: p.To}.Valid())
+}
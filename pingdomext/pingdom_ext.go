@@ -148,7 +148,7 @@ func obtainToken(config ClientConfig) (*string, error) {
 	if err != nil {
 		return nil, err
 	}
-	authReq.Header.Add("Content-Type", "application/json")
+	authReq.Header.Set("Content-Type", "application/json")
 
 	authResp, err := config.HTTPClient.Do(authReq)
 	if err != nil {
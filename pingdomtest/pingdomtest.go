@@ -0,0 +1,97 @@
+/*
+Package pingdomtest provides a fake Pingdom API server to unit test code
+using the pingdom package without reaching the real API.
+
+	srv := pingdomtest.NewServer()
+	defer srv.Close()
+
+	srv.Handle("/checks", http.StatusOK, `{"checks": []}`)
+
+	checks, err := srv.Client.Checks.List()
+
+Every request received by the server is recorded and can be inspected with
+Requests.
+*/
+package pingdomtest
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+
+	"github.com/mbarper/go-pingdom/pingdom"
+)
+
+// APIToken is the token used by the client returned by NewServer.
+const APIToken = "pingdomtest-token"
+
+// Request is a request recorded by a Server.
+type Request struct {
+	Method string
+	Path   string
+	Query  url.Values
+	Header http.Header
+	Body   string
+}
+
+// Server is a fake Pingdom API server along with a client talking to it.
+type Server struct {
+	*httptest.Server
+	Mux    *http.ServeMux
+	Client *pingdom.Client
+
+	mu       sync.Mutex
+	requests []Request
+}
+
+// NewServer starts a fake Pingdom API server.  Responses are registered on
+// Mux, or with Handle.  The server must be closed with Close.
+func NewServer() *Server {
+	s := &Server{Mux: http.NewServeMux()}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.record))
+
+	client, err := pingdom.NewClient(APIToken, pingdom.WithBaseURL(s.Server.URL))
+	if err != nil {
+		s.Server.Close()
+		panic(fmt.Sprintf("pingdomtest: failed to create client: %v", err))
+	}
+	s.Client = client
+	return s
+}
+
+// Handle registers a canned JSON response for the given path.
+func (s *Server) Handle(path string, status int, body string) {
+	s.Mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		fmt.Fprint(w, body)
+	})
+}
+
+// Requests returns the requests received so far, in order.
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Request(nil), s.requests...)
+}
+
+func (s *Server) record(w http.ResponseWriter, r *http.Request) {
+	body, _ := ioutil.ReadAll(r.Body)
+
+	s.mu.Lock()
+	s.requests = append(s.requests, Request{
+		Method: r.Method,
+		Path:   r.URL.Path,
+		Query:  r.URL.Query(),
+		Header: r.Header.Clone(),
+		Body:   string(body),
+	})
+	s.mu.Unlock()
+
+	r.Body = ioutil.NopCloser(bytes.NewReader(body))
+	s.Mux.ServeHTTP(w, r)
+}
@@ -0,0 +1,46 @@
+package pingdomtest
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/mbarper/go-pingdom/pingdom"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServer(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	srv.Handle("/checks", http.StatusOK, `{"checks":[{"id":1,"name":"My check"}]}`)
+	srv.Handle("/alerting/teams", http.StatusOK, `{"team":{"id":2,"name":"My team"}}`)
+
+	checks, err := srv.Client.Checks.List(map[string]string{"tags": "prod"})
+	assert.NoError(t, err)
+	assert.Equal(t, []pingdom.CheckResponse{{ID: 1, Name: "My check"}}, checks)
+
+	team, err := srv.Client.Teams.Create(&pingdom.Team{Name: "My team"})
+	assert.NoError(t, err)
+	assert.Equal(t, &pingdom.TeamResponse{ID: 2, Name: "My team"}, team)
+
+	requests := srv.Requests()
+	assert.Len(t, requests, 2)
+	assert.Equal(t, "GET", requests[0].Method)
+	assert.Equal(t, "/checks", requests[0].Path)
+	assert.Equal(t, url.Values{"tags": {"prod"}}, requests[0].Query)
+	assert.Equal(t, "Bearer "+APIToken, requests[0].Header.Get("Authorization"))
+	assert.Equal(t, "POST", requests[1].Method)
+	assert.JSONEq(t, `{"name":"My team","member_ids":null}`, requests[1].Body)
+}
+
+func TestServerError(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	srv.Handle("/checks/1", http.StatusNotFound, `{"error":{"statuscode":404,"statusdesc":"Not Found","errormessage":"Check not found"}}`)
+
+	_, err := srv.Client.Checks.Read(1)
+	assert.True(t, errors.Is(err, pingdom.ErrNotFound))
+}
@@ -120,7 +120,7 @@ func (c *Client) NewRequest(method string, rsc string, params io.Reader) (*http.
 	if err != nil {
 		return nil, err
 	}
-	req.Header.Add("Content-Type", "application/json")
+	req.Header.Set("Content-Type", "application/json")
 	req.AddCookie(&http.Cookie{
 		Name:  cookieNameSwiSettings,
 		Value: c.swiSettings,